@@ -0,0 +1,18 @@
+action "rauthy_link_user_provider" "alice" {
+  config {
+    email       = "alice@example.com"
+    provider_id = rauthy_auth_provider.entra.id
+  }
+}
+
+# Move alice to the new provider once it exists, after unlinking the old one
+resource "terraform_data" "alice_migration" {
+  input = rauthy_auth_provider.entra.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.rauthy_unlink_user_provider.alice, action.rauthy_link_user_provider.alice]
+    }
+  }
+}
@@ -0,0 +1,17 @@
+action "rauthy_revoke_client_tokens" "google" {
+  config {
+    client_id = rauthy_client.google.id
+  }
+}
+
+# Kill every session of the client whenever its secret is rotated
+resource "terraform_data" "google_secret_rotation" {
+  input = rauthy_client_secret.google.created_at
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.rauthy_revoke_client_tokens.google]
+    }
+  }
+}
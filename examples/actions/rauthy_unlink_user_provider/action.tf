@@ -0,0 +1,6 @@
+action "rauthy_unlink_user_provider" "alice" {
+  config {
+    email       = "alice@example.com"
+    provider_id = rauthy_auth_provider.google.id
+  }
+}
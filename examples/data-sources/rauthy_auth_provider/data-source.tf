@@ -1,3 +1,11 @@
 data "rauthy_auth_provider" "google" {
   id = "google"
 }
+
+data "rauthy_auth_provider" "by_name" {
+  name = "Google"
+}
+
+data "rauthy_auth_provider" "by_issuer" {
+  issuer = "https://accounts.google.com"
+}
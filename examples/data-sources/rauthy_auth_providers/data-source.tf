@@ -0,0 +1,7 @@
+data "rauthy_auth_providers" "enabled" {
+  enabled = true
+}
+
+output "login_providers" {
+  value = { for p in data.rauthy_auth_providers.enabled.providers : p.name => p.issuer }
+}
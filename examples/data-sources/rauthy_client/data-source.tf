@@ -1,3 +1,11 @@
 data "rauthy_client" "test" {
   id = "test-client"
 }
+
+data "rauthy_client" "by_name" {
+  name = "Test Client"
+}
+
+data "rauthy_client" "by_redirect_uri" {
+  redirect_uri = "https://app.example.com/callback"
+}
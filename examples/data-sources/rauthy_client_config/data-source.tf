@@ -0,0 +1,16 @@
+data "rauthy_client_config" "app" {
+  client_id = "app"
+}
+
+output "oidc" {
+  value = {
+    client_id              = data.rauthy_client_config.app.client_id
+    issuer                 = data.rauthy_client_config.app.issuer
+    authorization_endpoint = data.rauthy_client_config.app.authorization_endpoint
+    token_endpoint         = data.rauthy_client_config.app.token_endpoint
+    userinfo_endpoint      = data.rauthy_client_config.app.userinfo_endpoint
+    jwks_uri               = data.rauthy_client_config.app.jwks_uri
+    scopes                 = data.rauthy_client_config.app.scopes
+    pkce_required          = data.rauthy_client_config.app.pkce_required
+  }
+}
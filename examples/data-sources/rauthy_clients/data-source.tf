@@ -0,0 +1,8 @@
+data "rauthy_clients" "confidential" {
+  enabled      = true
+  confidential = true
+}
+
+output "confidential_clients_without_pkce" {
+  value = [for c in data.rauthy_clients.confidential.clients : c.id if length(c.challenges) == 0]
+}
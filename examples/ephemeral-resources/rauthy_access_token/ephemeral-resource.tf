@@ -0,0 +1,12 @@
+ephemeral "rauthy_access_token" "smoke_test" {
+  client_id     = rauthy_client.api.id
+  client_secret = rauthy_client_secret.api.secret
+  scopes        = ["openid"]
+}
+
+provider "restapi" {
+  uri = "https://api.example.com"
+  headers = {
+    Authorization = "Bearer ${ephemeral.rauthy_access_token.smoke_test.access_token}"
+  }
+}
@@ -0,0 +1,14 @@
+ephemeral "rauthy_client_secret" "app" {
+  client_id = rauthy_client.app.id
+}
+
+resource "kubernetes_secret_v1" "app" {
+  metadata {
+    name = "app-oidc"
+  }
+
+  data_wo = {
+    client_secret = ephemeral.rauthy_client_secret.app.secret
+  }
+  data_wo_revision = 1
+}
@@ -0,0 +1,11 @@
+# Check a claim mapping against a sample ID token, e.g. in a `terraform test` assertion
+locals {
+  sample_claims = jsonencode({
+    sub   = "1234"
+    roles = ["user", "rauthy_admin"]
+  })
+}
+
+output "is_admin" {
+  value = contains(provider::rauthy::evaluate_claim_path("$.roles.*", local.sample_claims), "rauthy_admin")
+}
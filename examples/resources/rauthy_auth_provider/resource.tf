@@ -1,5 +1,4 @@
-resource "rauthy_provider" "default" {
-  id                     = "google"
+resource "rauthy_auth_provider" "default" {
   name                   = "Google"
   typ                    = "google"
   issuer                 = "https://accounts.google.com"
@@ -9,6 +8,59 @@ resource "rauthy_provider" "default" {
   token_endpoint         = "https://oauth2.googleapis.com/token"
   userinfo_endpoint      = "https://openidconnect.googleapis.com/v1/userinfo"
   jwks_endpoint          = "https://www.googleapis.com/oauth2/v3/certs"
-  scope                  = "openid profile email"
+  scopes                 = ["openid", "profile", "email"]
   enabled                = true
 }
+
+# Endpoints are resolved from https://accounts.google.com/.well-known/openid-configuration
+resource "rauthy_auth_provider" "discovered" {
+  name          = "Google"
+  typ           = "google"
+  issuer        = "https://accounts.google.com"
+  client_id     = "google-client-id"
+  client_secret = "google-client-secret"
+  scopes        = ["openid", "profile", "email"]
+  discover      = true
+}
+
+# The secret is read from Vault at apply time and never written to state,
+# bump client_secret_wo_version after rotating it
+ephemeral "vault_kv_secret_v2" "github" {
+  mount = "secret"
+  name  = "rauthy/github"
+}
+
+resource "rauthy_auth_provider" "github" {
+  name                     = "GitHub"
+  typ                      = "github"
+  issuer                   = "https://github.com"
+  client_id                = "github-client-id"
+  client_secret_wo         = ephemeral.vault_kv_secret_v2.github.data.client_secret
+  client_secret_wo_version = 1
+  authorization_endpoint   = "https://github.com/login/oauth/authorize"
+  token_endpoint           = "https://github.com/login/oauth/access_token"
+  userinfo_endpoint        = "https://api.github.com/user"
+  scopes                   = ["read:user", "user:email"]
+}
+
+# Issuer, endpoints, scopes, PKCE and client authentication come from the
+# github preset. Destroying it fails while users are linked to it, unless
+# force_destroy is set
+resource "rauthy_auth_provider" "github_minimal" {
+  name          = "GitHub"
+  typ           = "github"
+  client_id     = "github-client-id"
+  client_secret = "github-client-secret"
+  force_destroy = false
+}
+
+# Provider behind an internal CA
+resource "rauthy_auth_provider" "internal" {
+  name          = "Internal SSO"
+  typ           = "custom"
+  issuer        = "https://sso.internal.example.com"
+  client_id     = "rauthy"
+  client_secret = "internal-client-secret"
+  root_pem      = file("${path.module}/internal-root-ca.pem")
+  discover      = true
+}
@@ -0,0 +1,6 @@
+resource "rauthy_dynamic_client" "partner" {
+  client_name                = "Partner"
+  redirect_uris              = ["https://partner.example.com/callback"]
+  post_logout_redirect_uri   = "https://partner.example.com/logout"
+  token_endpoint_auth_method = "client_secret_basic"
+}
@@ -0,0 +1,11 @@
+resource "rauthy_ephemeral_client" "cli" {
+  id            = "https://cli.example.com/oidc/client.json"
+  client_name   = "Example CLI"
+  redirect_uris = ["http://localhost:8080/callback"]
+  grant_types   = ["authorization_code", "refresh_token"]
+}
+
+# Serve the document at the client ID URL, e.g. from an object storage bucket
+output "cli_metadata_document" {
+  value = rauthy_ephemeral_client.cli.metadata_document
+}
@@ -6,16 +6,34 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
 )
 
 var TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 	"rauthy": providerserver.NewProtocol6WithError(provider.New("test")()),
 }
 
+// TestAccProtoV6ProviderFactoriesWithEcho includes the echo provider, which
+// exposes ephemeral values in state so tests can assert on them.
+var TestAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
+	"rauthy": providerserver.NewProtocol6WithError(provider.New("test")()),
+	"echo":   echoprovider.NewProviderServer(),
+}
+
 func TestAccPreCheck(t *testing.T) {
 	apiKey := os.Getenv("RAUTHY_API_KEY")
 	if apiKey == "" {
 		t.Fatalf("RAUTHY_API_KEY environment variable is not set")
 	}
 }
+
+// TestAccClient builds a client from the same environment as the provider,
+// so tests can change resources behind Terraform's back.
+func TestAccClient() *rauthy.Client {
+	config := provider.ProviderConfig{}
+	config.FromEnv()
+
+	return rauthy.NewClient(config.Endpoint, config.Insecure, rauthy.NewApiKeyAuthenticator(config.APIKey))
+}
@@ -7,12 +7,14 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/utils"
 	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
 )
 
 var _ datasource.DataSource = &AuthProviderDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &AuthProviderDataSource{}
 
 func NewAuthProviderDataSource() datasource.DataSource {
 	return &AuthProviderDataSource{}
@@ -32,6 +34,7 @@ type AuthProviderDataSourceModel struct {
 	ClientSecret          types.String `tfsdk:"client_secret"`
 	ClientSecretBasic     types.Bool   `tfsdk:"client_secret_basic"`
 	ClientSecretPost      types.Bool   `tfsdk:"client_secret_post"`
+	DangerAllowInsecure   types.Bool   `tfsdk:"danger_allow_insecure"`
 	Enabled               types.Bool   `tfsdk:"enabled"`
 	Id                    types.String `tfsdk:"id"`
 	Issuer                types.String `tfsdk:"issuer"`
@@ -39,6 +42,7 @@ type AuthProviderDataSourceModel struct {
 	MfaClaimPath          types.String `tfsdk:"mfa_claim_path"`
 	MfaClaimValue         types.String `tfsdk:"mfa_claim_value"`
 	Name                  types.String `tfsdk:"name"`
+	RootPem               types.String `tfsdk:"root_pem"`
 	Scope                 types.String `tfsdk:"scope"`
 	TokenEndpoint         types.String `tfsdk:"token_endpoint"`
 	Typ                   types.String `tfsdk:"typ"`
@@ -56,15 +60,18 @@ func (d *AuthProviderDataSource) Schema(ctx context.Context, req datasource.Sche
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "Provider ID",
-				Required:            true,
+				MarkdownDescription: "Provider ID. Exactly one of `id`, `name` or `issuer` must be set",
+				Optional:            true,
+				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Provider Name",
+				MarkdownDescription: "Provider Name. Exactly one of `id`, `name` or `issuer` must be set",
+				Optional:            true,
 				Computed:            true,
 			},
 			"issuer": schema.StringAttribute{
-				MarkdownDescription: "Provider Issuer",
+				MarkdownDescription: "Provider Issuer. Exactly one of `id`, `name` or `issuer` must be set",
+				Optional:            true,
 				Computed:            true,
 			},
 			"client_id": schema.StringAttribute{
@@ -141,6 +148,14 @@ func (d *AuthProviderDataSource) Schema(ctx context.Context, req datasource.Sche
 				MarkdownDescription: "Use PKCE",
 				Computed:            true,
 			},
+			"root_pem": schema.StringAttribute{
+				MarkdownDescription: "Root certificate chain used to verify the provider",
+				Computed:            true,
+			},
+			"danger_allow_insecure": schema.BoolAttribute{
+				MarkdownDescription: "Whether TLS verification of the provider is skipped",
+				Computed:            true,
+			},
 			"typ": schema.StringAttribute{
 				MarkdownDescription: "Type",
 				Computed:            true,
@@ -168,6 +183,74 @@ func (d *AuthProviderDataSource) Configure(ctx context.Context, req datasource.C
 	d.client = client
 }
 
+func (d *AuthProviderDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data AuthProviderDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configured := 0
+	for _, v := range []types.String{data.Id, data.Name, data.Issuer} {
+		if !v.IsNull() {
+			configured++
+		}
+	}
+
+	if configured != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Attribute Combination",
+			"Exactly one of `id`, `name` or `issuer` must be set",
+		)
+	}
+}
+
+// findAuthProvider resolves the provider by whichever lookup attribute is set.
+func (d *AuthProviderDataSource) findAuthProvider(ctx context.Context, data *AuthProviderDataSourceModel) (*rauthy.AuthProvider, error) {
+	if !data.Id.IsNull() {
+		return d.client.GetAuthProvider(ctx, data.Id.ValueString())
+	}
+
+	providers, err := d.client.GetAuthProviders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lookup := fmt.Sprintf("issuer %q", data.Issuer.ValueString())
+	if !data.Name.IsNull() {
+		lookup = fmt.Sprintf("name %q", data.Name.ValueString())
+	}
+
+	var matches []rauthy.AuthProvider
+
+	for _, p := range providers {
+		if !data.Name.IsNull() && p.Name == data.Name.ValueString() {
+			matches = append(matches, p)
+		}
+
+		if !data.Issuer.IsNull() && p.Issuer == data.Issuer.ValueString() {
+			matches = append(matches, p)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no provider found with %s", lookup)
+	case 1:
+		return &matches[0], nil
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, p := range matches {
+			ids = append(ids, p.Id)
+		}
+
+		return nil, fmt.Errorf("%d providers found with %s: %s, use `id` to select one", len(matches), lookup, strings.Join(ids, ", "))
+	}
+}
+
 func (d *AuthProviderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data AuthProviderDataSourceModel
 
@@ -177,13 +260,14 @@ func (d *AuthProviderDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	provider, err := d.client.GetAuthProvider(ctx, data.Id.ValueString())
+	provider, err := d.findAuthProvider(ctx, &data)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OIDC provider, got error: %s", err))
 		return
 	}
 
 	// Map fields
+	data.Id = types.StringValue(provider.Id)
 	data.Name = types.StringValue(provider.Name)
 	data.Issuer = types.StringValue(provider.Issuer)
 	data.ClientId = types.StringValue(provider.ClientId)
@@ -197,6 +281,8 @@ func (d *AuthProviderDataSource) Read(ctx context.Context, req datasource.ReadRe
 	data.AutoOnboarding = types.BoolValue(provider.AutoOnboarding)
 	data.ClientSecretBasic = types.BoolValue(provider.ClientSecretBasic)
 	data.ClientSecretPost = types.BoolValue(provider.ClientSecretPost)
+	data.RootPem = utils.StringPtrToFramework(provider.RootPem)
+	data.DangerAllowInsecure = types.BoolValue(provider.DangerAllowInsecure)
 	data.UsePkce = types.BoolValue(provider.UsePkce)
 	data.Typ = types.StringValue(provider.Typ)
 
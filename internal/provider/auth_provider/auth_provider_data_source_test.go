@@ -2,6 +2,7 @@ package auth_provider_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -20,6 +21,64 @@ func TestAccAuthProviderDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.rauthy_auth_provider.test", "id"),
 					resource.TestCheckResourceAttr("data.rauthy_auth_provider.test", "issuer", "https://accounts.google.com"),
 					resource.TestCheckResourceAttr("data.rauthy_auth_provider.test", "client_id", "google-client-id"),
+					resource.TestCheckResourceAttr("data.rauthy_auth_provider.test", "danger_allow_insecure", "false"),
+					resource.TestCheckNoResourceAttr("data.rauthy_auth_provider.test", "root_pem"),
+					resource.TestCheckResourceAttrPair("data.rauthy_auth_provider.by_name", "id", "rauthy_auth_provider.test", "id"),
+					resource.TestCheckResourceAttrPair("data.rauthy_auth_provider.by_issuer", "id", "rauthy_auth_provider.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuthProviderDataSource_InvalidLookup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "rauthy_auth_provider" "invalid" {
+	id = "google"
+	name = "Google"
+}
+`,
+				ExpectError: regexp.MustCompile("Exactly one of `id`, `name` or `issuer` must be set"),
+			},
+		},
+	})
+}
+
+func TestAccAuthProvidersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthProviderDataSourceConfig("google-ds", "Google DS") + `
+data "rauthy_auth_providers" "google" {
+	enabled = true
+	typ = "google"
+
+	depends_on = [rauthy_auth_provider.test]
+}
+
+data "rauthy_auth_providers" "disabled" {
+	enabled = false
+
+	depends_on = [rauthy_auth_provider.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair("data.rauthy_auth_providers.google", "ids.*", "rauthy_auth_provider.test", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.rauthy_auth_providers.google", "providers.*", map[string]string{
+						"name":      "Google DS",
+						"client_id": "google-client-id",
+						"scope":     "openid profile email",
+					}),
+					resource.TestCheckTypeSetElemAttr("data.rauthy_auth_providers.google", "providers.0.scopes.*", "email"),
+					resource.TestCheckNoResourceAttr("data.rauthy_auth_providers.google", "providers.0.client_secret"),
+					resource.TestCheckResourceAttr("data.rauthy_auth_providers.disabled", "ids.#", "0"),
 				),
 			},
 		},
@@ -45,5 +104,13 @@ resource "rauthy_auth_provider" "test" {
 data "rauthy_auth_provider" "test" {
 	id = rauthy_auth_provider.test.id
 }
+
+data "rauthy_auth_provider" "by_name" {
+	name = rauthy_auth_provider.test.name
+}
+
+data "rauthy_auth_provider" "by_issuer" {
+	issuer = rauthy_auth_provider.test.issuer
+}
 `, id, name)
 }
@@ -0,0 +1,207 @@
+package auth_provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/tfutils"
+)
+
+// authProviderPreset holds the settings known to work for a provider type.
+// Empty values have no default and must be configured or discovered.
+type authProviderPreset struct {
+	Issuer                string
+	AuthorizationEndpoint string
+	TokenEndpoint         string
+	UserinfoEndpoint      string
+	JwksEndpoint          string
+	Scope                 string
+	RequiredScopes        []string
+	ClientSecretBasic     bool
+	ClientSecretPost      bool
+	UsePkce               bool
+	Discovery             bool
+}
+
+var authProviderPresets = map[string]authProviderPreset{
+	rauthy.AuthProviderTypeCustom: {
+		ClientSecretBasic: true,
+		UsePkce:           true,
+		Discovery:         true,
+	},
+	rauthy.AuthProviderTypeGithub: {
+		Issuer:                "https://github.com",
+		AuthorizationEndpoint: "https://github.com/login/oauth/authorize",
+		TokenEndpoint:         "https://github.com/login/oauth/access_token",
+		UserinfoEndpoint:      "https://api.github.com/user",
+		Scope:                 "read:user user:email",
+		RequiredScopes:        []string{"user:email"},
+		ClientSecretPost:      true,
+		UsePkce:               true,
+	},
+	rauthy.AuthProviderTypeGitlab: {
+		Issuer:                "https://gitlab.com",
+		AuthorizationEndpoint: "https://gitlab.com/oauth/authorize",
+		TokenEndpoint:         "https://gitlab.com/oauth/token",
+		UserinfoEndpoint:      "https://gitlab.com/oauth/userinfo",
+		JwksEndpoint:          "https://gitlab.com/oauth/discovery/keys",
+		Scope:                 "openid profile email",
+		RequiredScopes:        []string{"openid", "email"},
+		ClientSecretBasic:     true,
+		UsePkce:               true,
+		Discovery:             true,
+	},
+	rauthy.AuthProviderTypeGoogle: {
+		Issuer:                "https://accounts.google.com",
+		AuthorizationEndpoint: "https://accounts.google.com/o/oauth2/v2/auth",
+		TokenEndpoint:         "https://oauth2.googleapis.com/token",
+		UserinfoEndpoint:      "https://openidconnect.googleapis.com/v1/userinfo",
+		JwksEndpoint:          "https://www.googleapis.com/oauth2/v3/certs",
+		Scope:                 "openid profile email",
+		RequiredScopes:        []string{"openid", "email"},
+		ClientSecretBasic:     true,
+		UsePkce:               true,
+		Discovery:             true,
+	},
+	// The issuer contains the tenant, so the endpoints must be configured
+	// or discovered
+	rauthy.AuthProviderTypeMicrosoft: {
+		Scope:             "openid profile email",
+		RequiredScopes:    []string{"openid", "email"},
+		ClientSecretBasic: true,
+		UsePkce:           true,
+		Discovery:         true,
+	},
+}
+
+func (p authProviderPreset) endpoints() map[string]string {
+	return map[string]string{
+		"authorization_endpoint": p.AuthorizationEndpoint,
+		"token_endpoint":         p.TokenEndpoint,
+		"userinfo_endpoint":      p.UserinfoEndpoint,
+		"jwks_endpoint":          p.JwksEndpoint,
+	}
+}
+
+// validatePreset checks config against the rules of its provider type.
+func validatePreset(config *AuthProviderResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if config.Typ.IsUnknown() {
+		return diags
+	}
+
+	typ := config.Typ.ValueString()
+	preset, ok := authProviderPresets[typ]
+
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("typ"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Provider type %q is not supported, expected one of: %s", typ, strings.Join(rauthy.AuthProviderTypes, ", ")),
+		)
+
+		return diags
+	}
+
+	if config.Discover.ValueBool() && !preset.Discovery {
+		diags.AddAttributeError(
+			path.Root("discover"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Provider type %q does not support OIDC discovery", typ),
+		)
+	}
+
+	if config.Issuer.IsNull() && preset.Issuer == "" {
+		diags.AddAttributeError(
+			path.Root("issuer"),
+			"Missing Required Attribute",
+			fmt.Sprintf("`issuer` is required for provider type %q", typ),
+		)
+	}
+
+	if !config.Discover.ValueBool() {
+		presetEndpoints := preset.endpoints()
+
+		for name, endpoint := range config.endpoints() {
+			if name != "jwks_endpoint" && endpoint.IsNull() && presetEndpoints[name] == "" {
+				diags.AddAttributeError(
+					path.Root(name),
+					"Missing Required Attribute",
+					fmt.Sprintf("`%s` is required for provider type %q unless `discover` is set", name, typ),
+				)
+			}
+		}
+	}
+
+	scopesPath, scopes := path.Root("scopes"), tfutils.SetToStringSlice(config.Scopes)
+
+	if !config.Scope.IsNull() {
+		scopesPath, scopes = path.Root("scope"), rauthy.ParseScope(config.Scope.ValueString())
+	}
+
+	if !config.Scope.IsUnknown() && !config.Scopes.IsUnknown() && (!config.Scope.IsNull() || !config.Scopes.IsNull()) {
+		for _, required := range preset.RequiredScopes {
+			if !slices.Contains(scopes, required) {
+				diags.AddAttributeError(
+					scopesPath,
+					"Invalid Attribute Value",
+					fmt.Sprintf("Provider type %q requires the %q scope", typ, required),
+				)
+			}
+		}
+	}
+
+	return diags
+}
+
+// applyPreset fills the attributes which are not configured with the
+// defaults of the provider type.
+func applyPreset(config *AuthProviderResourceModel, plan *AuthProviderResourceModel) {
+	preset, ok := authProviderPresets[plan.Typ.ValueString()]
+
+	if !ok {
+		return
+	}
+
+	if config.Issuer.IsNull() && preset.Issuer != "" {
+		plan.Issuer = types.StringValue(preset.Issuer)
+	}
+
+	if config.Scope.IsNull() && config.Scopes.IsNull() && preset.Scope != "" {
+		plan.Scope = types.StringValue(preset.Scope)
+		plan.Scopes = tfutils.StringSliceToSet(rauthy.ParseScope(preset.Scope))
+	}
+
+	if config.ClientSecretBasic.IsNull() {
+		plan.ClientSecretBasic = types.BoolValue(preset.ClientSecretBasic)
+	}
+
+	if config.ClientSecretPost.IsNull() {
+		plan.ClientSecretPost = types.BoolValue(preset.ClientSecretPost)
+	}
+
+	if config.UsePkce.IsNull() {
+		plan.UsePkce = types.BoolValue(preset.UsePkce)
+	}
+
+	if plan.Discover.ValueBool() {
+		return
+	}
+
+	configEndpoints := config.endpoints()
+	presetEndpoints := preset.endpoints()
+
+	// Without discovery only the preset supplies endpoints, an endpoint removed
+	// from config is cleared instead of keeping the value from state
+	for name, endpoint := range plan.endpoints() {
+		if configEndpoints[name].IsNull() {
+			*endpoint = types.StringValue(presetEndpoints[name])
+		}
+	}
+}
@@ -3,21 +3,29 @@ package auth_provider
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/utils"
 	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/tfutils"
 )
 
 var _ resource.Resource = &AuthProviderResource{}
 var _ resource.ResourceWithImportState = &AuthProviderResource{}
+var _ resource.ResourceWithValidateConfig = &AuthProviderResource{}
+var _ resource.ResourceWithModifyPlan = &AuthProviderResource{}
+var _ resource.ResourceWithUpgradeState = &AuthProviderResource{}
 
 func NewAuthProviderResource() resource.Resource {
 	return &AuthProviderResource{}
@@ -35,16 +43,23 @@ type AuthProviderResourceModel struct {
 	AutoOnboarding        types.Bool   `tfsdk:"auto_onboarding"`
 	ClientId              types.String `tfsdk:"client_id"`
 	ClientSecret          types.String `tfsdk:"client_secret"`
+	ClientSecretWo        types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWoVersion types.Int64  `tfsdk:"client_secret_wo_version"`
 	ClientSecretBasic     types.Bool   `tfsdk:"client_secret_basic"`
 	ClientSecretPost      types.Bool   `tfsdk:"client_secret_post"`
+	DangerAllowInsecure   types.Bool   `tfsdk:"danger_allow_insecure"`
+	Discover              types.Bool   `tfsdk:"discover"`
 	Enabled               types.Bool   `tfsdk:"enabled"`
+	ForceDestroy          types.Bool   `tfsdk:"force_destroy"`
 	Id                    types.String `tfsdk:"id"`
 	Issuer                types.String `tfsdk:"issuer"`
 	JwksEndpoint          types.String `tfsdk:"jwks_endpoint"`
 	MfaClaimPath          types.String `tfsdk:"mfa_claim_path"`
 	MfaClaimValue         types.String `tfsdk:"mfa_claim_value"`
 	Name                  types.String `tfsdk:"name"`
+	RootPem               types.String `tfsdk:"root_pem"`
 	Scope                 types.String `tfsdk:"scope"`
+	Scopes                types.Set    `tfsdk:"scopes"`
 	TokenEndpoint         types.String `tfsdk:"token_endpoint"`
 	Typ                   types.String `tfsdk:"typ"`
 	UsePkce               types.Bool   `tfsdk:"use_pkce"`
@@ -58,6 +73,7 @@ func (r *AuthProviderResource) Metadata(ctx context.Context, req resource.Metada
 func (r *AuthProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "OIDC Provider resource",
+		Version:             1,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -72,40 +88,84 @@ func (r *AuthProviderResource) Schema(ctx context.Context, req resource.SchemaRe
 				Required:            true,
 			},
 			"issuer": schema.StringAttribute{
-				MarkdownDescription: "Provider Issuer",
-				Required:            true,
+				MarkdownDescription: "Provider Issuer, defaults to the issuer of `typ` when it has a fixed one",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"client_id": schema.StringAttribute{
 				MarkdownDescription: "Client ID",
 				Required:            true,
 			},
 			"client_secret": schema.StringAttribute{
-				MarkdownDescription: "Client Secret",
-				Required:            true,
+				MarkdownDescription: "Client Secret, stored in state. Exactly one of `client_secret` and `client_secret_wo` must be set, use `client_secret_wo` to keep it out of state",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"client_secret_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only client secret, never stored in plan or state. Requires Terraform 1.11 or later",
+				Optional:            true,
 				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"client_secret_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Increment to send a new `client_secret_wo` to Rauthy, changes of the write-only value alone are not detected",
+				Optional:            true,
 			},
 			"authorization_endpoint": schema.StringAttribute{
-				MarkdownDescription: "Authorization Endpoint",
+				MarkdownDescription: "Authorization Endpoint, resolved from the issuer when `discover` is set",
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"token_endpoint": schema.StringAttribute{
-				MarkdownDescription: "Token Endpoint",
+				MarkdownDescription: "Token Endpoint, resolved from the issuer when `discover` is set",
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"userinfo_endpoint": schema.StringAttribute{
-				MarkdownDescription: "Userinfo Endpoint",
+				MarkdownDescription: "Userinfo Endpoint, resolved from the issuer when `discover` is set",
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"jwks_endpoint": schema.StringAttribute{
-				MarkdownDescription: "JWKS Endpoint",
+				MarkdownDescription: "JWKS Endpoint, resolved from the issuer when `discover` is set",
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"scope": schema.StringAttribute{
-				MarkdownDescription: "Scope",
+				MarkdownDescription: "Space separated scopes, defaults to the scopes `typ` needs",
+				DeprecationMessage:  "Use `scopes` instead, `scope` will be removed in a future release",
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scopes": schema.SetAttribute{
+				MarkdownDescription: "Scopes requested from the provider, defaults to the scopes `typ` needs",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"admin_claim_path": schema.StringAttribute{
-				MarkdownDescription: "Admin Claim Path",
+				MarkdownDescription: "JSON path into the upstream ID token claims, users are made admins when it selects `admin_claim_value`, e.g. `$.roles.*`",
 				Optional:            true,
 			},
 			"admin_claim_value": schema.StringAttribute{
@@ -113,13 +173,38 @@ func (r *AuthProviderResource) Schema(ctx context.Context, req resource.SchemaRe
 				Optional:            true,
 			},
 			"mfa_claim_path": schema.StringAttribute{
-				MarkdownDescription: "MFA Claim Path",
+				MarkdownDescription: "JSON path into the upstream ID token claims, logins count as MFA when it selects `mfa_claim_value`, e.g. `$.amr.*`",
 				Optional:            true,
 			},
 			"mfa_claim_value": schema.StringAttribute{
 				MarkdownDescription: "MFA Claim Value",
 				Optional:            true,
 			},
+			"root_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded root certificate chain used to verify the TLS connections to the provider, " +
+					"for providers behind an internal CA",
+				Optional: true,
+			},
+			"danger_allow_insecure": schema.BoolAttribute{
+				MarkdownDescription: "Allow plain HTTP and skip TLS verification for requests to the provider. Only use this for testing",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"discover": schema.BoolAttribute{
+				MarkdownDescription: "Resolve the endpoints from the issuer's `.well-known/openid-configuration` through Rauthy. " +
+					"The endpoints are looked up again on every plan and a warning is shown when they changed upstream",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Delete the provider even when users are linked to it. " +
+					"Linked users can no longer log in through the provider afterwards",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"enabled": schema.BoolAttribute{
 				MarkdownDescription: "Enabled",
 				Optional:            true,
@@ -157,8 +242,9 @@ func (r *AuthProviderResource) Schema(ctx context.Context, req resource.SchemaRe
 				Default:             booldefault.StaticBool(true),
 			},
 			"typ": schema.StringAttribute{
-				MarkdownDescription: "Type",
-				Required:            true,
+				MarkdownDescription: "Type, one of `custom`, `github`, `gitlab`, `google` or `microsoft`. " +
+					"Issuer, endpoints, scope, PKCE and client authentication default to what the type needs when not set",
+				Required: true,
 			},
 		},
 	}
@@ -183,6 +269,185 @@ func (r *AuthProviderResource) Configure(ctx context.Context, req resource.Confi
 	r.client = client
 }
 
+func (r *AuthProviderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AuthProviderResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ClientSecret.IsNull() && !data.ClientSecretWo.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_secret_wo"),
+			"Invalid Attribute Combination",
+			"Only one of `client_secret` and `client_secret_wo` can be set",
+		)
+	}
+
+	// Rauthy would otherwise store an empty secret
+	if data.ClientSecret.IsNull() && data.ClientSecretWo.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_secret"),
+			"Missing Required Attribute",
+			"One of `client_secret` and `client_secret_wo` must be set",
+		)
+	}
+
+	if !data.ClientSecretWoVersion.IsNull() && data.ClientSecretWo.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_secret_wo_version"),
+			"Invalid Attribute Combination",
+			"`client_secret_wo_version` has no effect without `client_secret_wo`",
+		)
+	}
+
+	if !data.Scope.IsNull() && !data.Scopes.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("scopes"),
+			"Invalid Attribute Combination",
+			"Only one of `scope` and `scopes` can be set",
+		)
+	}
+
+	resp.Diagnostics.Append(validatePreset(&data)...)
+
+	for name, claimPath := range map[string]types.String{"admin_claim_path": data.AdminClaimPath, "mfa_claim_path": data.MfaClaimPath} {
+		if claimPath.IsNull() || claimPath.IsUnknown() {
+			continue
+		}
+
+		if _, err := rauthy.ParseClaimPath(claimPath.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Attribute Value", err.Error())
+		}
+	}
+
+	if !data.RootPem.IsNull() && !data.RootPem.IsUnknown() {
+		if err := rauthy.ValidateRootPem(data.RootPem.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("root_pem"), "Invalid Attribute Value", fmt.Sprintf("Invalid certificate chain: %s", err))
+		}
+	}
+
+	if !data.Discover.ValueBool() {
+		return
+	}
+
+	for name, endpoint := range data.endpoints() {
+		if !endpoint.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("`%s` is resolved from the issuer when `discover` is set and cannot be configured", name),
+			)
+		}
+	}
+}
+
+func (r *AuthProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, config AuthProviderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applyPreset(&config, &plan)
+	syncScopes(&config, &plan)
+
+	if plan.DangerAllowInsecure.ValueBool() {
+		// The issuer may only be known after apply
+		upstream := "the upstream provider"
+		if issuer := plan.Issuer.ValueString(); issuer != "" {
+			upstream = issuer
+		}
+
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("danger_allow_insecure"),
+			"Insecure Upstream Requests",
+			fmt.Sprintf("Rauthy will not verify the TLS certificate of %s, anyone on the network path can impersonate the provider and log in as any user.", upstream),
+		)
+	}
+
+	if !plan.Discover.ValueBool() || r.client == nil {
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	// The issuer is only known after apply, resolve the endpoints then
+	if plan.Issuer.IsUnknown() {
+		for _, endpoint := range plan.endpoints() {
+			*endpoint = types.StringUnknown()
+		}
+
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	changes, err := r.discover(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to discover endpoints of %s, got error: %s", plan.Issuer.ValueString(), err))
+		return
+	}
+
+	if len(changes) > 0 && !req.State.Raw.IsNull() {
+		var state AuthProviderResourceModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if state.Issuer.Equal(plan.Issuer) {
+			resp.Diagnostics.AddWarning(
+				"Upstream Metadata Changed",
+				fmt.Sprintf("The metadata of %s no longer matches the provider, it will be updated:\n%s", plan.Issuer.ValueString(), strings.Join(changes, "\n")),
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// discover resolves the endpoints of data from its issuer and returns the
+// endpoints which differ from the known values.
+func (r *AuthProviderResource) discover(ctx context.Context, data *AuthProviderResourceModel) ([]string, error) {
+	lookup, err := r.client.LookupAuthProvider(ctx, &rauthy.AuthProviderLookupRequest{
+		Issuer:              data.Issuer.ValueString(),
+		RootPem:             utils.FrameworkToStringPtr(data.RootPem),
+		DangerAllowInsecure: data.DangerAllowInsecure.ValueBool(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	discovered := map[string]string{
+		"authorization_endpoint": lookup.AuthorizationEndpoint,
+		"token_endpoint":         lookup.TokenEndpoint,
+		"userinfo_endpoint":      lookup.UserinfoEndpoint,
+		"jwks_endpoint":          lookup.JwksEndpoint,
+	}
+
+	var changes []string
+
+	for name, endpoint := range data.endpoints() {
+		value := discovered[name]
+
+		if !endpoint.IsUnknown() && !endpoint.IsNull() && endpoint.ValueString() != value {
+			changes = append(changes, fmt.Sprintf("%s: %s -> %s", name, endpoint.ValueString(), value))
+		}
+
+		*endpoint = types.StringValue(value)
+	}
+
+	slices.Sort(changes)
+
+	return changes, nil
+}
+
 func (r *AuthProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data AuthProviderResourceModel
 
@@ -192,7 +457,20 @@ func (r *AuthProviderResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	if data.Discover.ValueBool() && data.AuthorizationEndpoint.IsUnknown() {
+		if _, err := r.discover(ctx, &data); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to discover endpoints of %s, got error: %s", data.Issuer.ValueString(), err))
+			return
+		}
+	}
+
 	apiModel := data.ToApi()
+	resp.Diagnostics.Append(applyWriteOnlySecret(ctx, req.Config, &apiModel)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	newProvider, err := r.client.CreateAuthProvider(ctx, &apiModel)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create OIDC provider, got error: %s", err))
@@ -221,6 +499,15 @@ func (r *AuthProviderResource) Read(ctx context.Context, req resource.ReadReques
 
 	data.FromApiResource(provider)
 
+	// Not stored in Rauthy, imported providers use the defaults
+	if data.Discover.IsNull() {
+		data.Discover = types.BoolValue(false)
+	}
+
+	if data.ForceDestroy.IsNull() {
+		data.ForceDestroy = types.BoolValue(false)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -233,7 +520,20 @@ func (r *AuthProviderResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	if data.Discover.ValueBool() && data.AuthorizationEndpoint.IsUnknown() {
+		if _, err := r.discover(ctx, &data); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to discover endpoints of %s, got error: %s", data.Issuer.ValueString(), err))
+			return
+		}
+	}
+
 	apiModel := data.ToApi()
+	resp.Diagnostics.Append(applyWriteOnlySecret(ctx, req.Config, &apiModel)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	provider, err := r.client.UpdateAuthProvider(ctx, data.Id.ValueString(), &apiModel)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update OIDC provider, got error: %s", err))
@@ -254,6 +554,29 @@ func (r *AuthProviderResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	if !data.ForceDestroy.ValueBool() {
+		users, err := r.client.GetAuthProviderLinkedUsers(ctx, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check users linked to OIDC provider, got error: %s", err))
+			return
+		}
+
+		if len(users) > 0 {
+			resp.Diagnostics.AddError(
+				"OIDC Provider In Use",
+				fmt.Sprintf(
+					"%d users are linked to OIDC provider %s and would no longer be able to log in: %s. "+
+						"Unlink them first or set `force_destroy = true` to delete it anyway.",
+					len(users),
+					data.Name.ValueString(),
+					linkedUserEmails(users),
+				),
+			)
+
+			return
+		}
+	}
+
 	if err := r.client.DeleteAuthProvider(ctx, data.Id.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete OIDC provider, got error: %s", err))
 		return
@@ -264,6 +587,84 @@ func (r *AuthProviderResource) ImportState(ctx context.Context, req resource.Imp
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// linkedUserEmails lists the emails of the first linked users, enough to
+// identify who is affected without flooding the output.
+func linkedUserEmails(users []rauthy.AuthProviderLinkedUser) string {
+	const limit = 10
+
+	emails := make([]string, 0, limit)
+
+	for _, user := range users[:min(len(users), limit)] {
+		emails = append(emails, user.Email)
+	}
+
+	if len(users) > limit {
+		emails = append(emails, fmt.Sprintf("and %d more", len(users)-limit))
+	}
+
+	return strings.Join(emails, ", ")
+}
+
+// syncScopes derives the scope attribute which is not configured from the
+// one which is, so both stay consistent while `scope` is deprecated.
+func syncScopes(config *AuthProviderResourceModel, plan *AuthProviderResourceModel) {
+	switch {
+	case !config.Scopes.IsNull():
+		if config.Scopes.IsUnknown() {
+			plan.Scope = types.StringUnknown()
+			return
+		}
+
+		scopes := tfutils.SetToStringSlice(config.Scopes)
+
+		// Keep the prior order of `scope` when only the order differs
+		if plan.Scope.IsNull() || plan.Scope.IsUnknown() || !slices.Equal(rauthy.ParseScope(plan.Scope.ValueString()), rauthy.ParseScope(strings.Join(scopes, " "))) {
+			plan.Scope = types.StringValue(rauthy.FormatScope(scopes))
+		}
+	case !config.Scope.IsNull():
+		if config.Scope.IsUnknown() {
+			plan.Scopes = types.SetUnknown(types.StringType)
+			return
+		}
+
+		plan.Scopes = tfutils.StringSliceToSet(rauthy.ParseScope(config.Scope.ValueString()))
+	}
+}
+
+// applyWriteOnlySecret sends `client_secret_wo` instead of `client_secret`
+// when it is set. Write-only values are only available in the config.
+func applyWriteOnlySecret(ctx context.Context, config tfsdk.Config, provider *rauthy.AuthProvider) diag.Diagnostics {
+	var secret types.String
+
+	diags := config.GetAttribute(ctx, path.Root("client_secret_wo"), &secret)
+
+	if !secret.IsNull() {
+		provider.ClientSecret = secret.ValueString()
+	}
+
+	return diags
+}
+
+func (r *AuthProviderResourceModel) endpoints() map[string]*types.String {
+	return map[string]*types.String{
+		"authorization_endpoint": &r.AuthorizationEndpoint,
+		"token_endpoint":         &r.TokenEndpoint,
+		"userinfo_endpoint":      &r.UserinfoEndpoint,
+		"jwks_endpoint":          &r.JwksEndpoint,
+	}
+}
+
+// wireScope returns the scopes in Rauthy's format. `scope` is kept in sync
+// with `scopes` and holds the order Rauthy returns them in, so it is
+// preferred while known.
+func (r *AuthProviderResourceModel) wireScope() string {
+	if r.Scope.IsNull() || r.Scope.IsUnknown() {
+		return rauthy.FormatScope(tfutils.SetToStringSlice(r.Scopes))
+	}
+
+	return rauthy.FormatScope([]string{r.Scope.ValueString()})
+}
+
 func (r *AuthProviderResourceModel) ToApi() rauthy.AuthProvider {
 	return rauthy.AuthProvider{
 		Id:                    r.Id.ValueString(),
@@ -275,7 +676,7 @@ func (r *AuthProviderResourceModel) ToApi() rauthy.AuthProvider {
 		TokenEndpoint:         r.TokenEndpoint.ValueString(),
 		UserinfoEndpoint:      r.UserinfoEndpoint.ValueString(),
 		JwksEndpoint:          r.JwksEndpoint.ValueString(),
-		Scope:                 r.Scope.ValueString(),
+		Scope:                 r.wireScope(),
 		AdminClaimPath:        utils.FrameworkToStringPtr(r.AdminClaimPath),
 		AdminClaimValue:       utils.FrameworkToStringPtr(r.AdminClaimValue),
 		MfaClaimPath:          utils.FrameworkToStringPtr(r.MfaClaimPath),
@@ -285,6 +686,8 @@ func (r *AuthProviderResourceModel) ToApi() rauthy.AuthProvider {
 		AutoOnboarding:        r.AutoOnboarding.ValueBool(),
 		ClientSecretBasic:     r.ClientSecretBasic.ValueBool(),
 		ClientSecretPost:      r.ClientSecretPost.ValueBool(),
+		RootPem:               utils.FrameworkToStringPtr(r.RootPem),
+		DangerAllowInsecure:   r.DangerAllowInsecure.ValueBool(),
 		UsePkce:               r.UsePkce.ValueBool(),
 		Typ:                   r.Typ.ValueString(),
 	}
@@ -295,7 +698,9 @@ func (r *AuthProviderResourceModel) FromApiResource(provider *rauthy.AuthProvide
 	r.Name = types.StringValue(provider.Name)
 	r.Issuer = types.StringValue(provider.Issuer)
 	r.ClientId = types.StringValue(provider.ClientId)
-	r.ClientSecret = types.StringValue(provider.ClientSecret)
+
+	// client_secret is kept as configured, the API returns it blank or masked
+
 	r.AuthorizationEndpoint = types.StringValue(provider.AuthorizationEndpoint)
 	r.TokenEndpoint = types.StringValue(provider.TokenEndpoint)
 	r.UserinfoEndpoint = types.StringValue(provider.UserinfoEndpoint)
@@ -305,14 +710,122 @@ func (r *AuthProviderResourceModel) FromApiResource(provider *rauthy.AuthProvide
 	r.AutoOnboarding = types.BoolValue(provider.AutoOnboarding)
 	r.ClientSecretBasic = types.BoolValue(provider.ClientSecretBasic)
 	r.ClientSecretPost = types.BoolValue(provider.ClientSecretPost)
+	r.RootPem = utils.StringPtrToFramework(provider.RootPem)
+	r.DangerAllowInsecure = types.BoolValue(provider.DangerAllowInsecure)
 	r.UsePkce = types.BoolValue(provider.UsePkce)
 	r.Typ = types.StringValue(provider.Typ)
 
-	// Transform scope: API returns "openid+profile+email", normalize to "openid profile email"
-	r.Scope = types.StringValue(strings.ReplaceAll(provider.Scope, "+", " "))
+	scopes := rauthy.ParseScope(provider.Scope)
+	r.Scopes = tfutils.StringSliceToSet(scopes)
+
+	// Keep the configured order of `scope`, otherwise normalize the API format "openid+profile+email" to "openid profile email"
+	if r.Scope.IsNull() || r.Scope.IsUnknown() || !slices.Equal(rauthy.ParseScope(r.Scope.ValueString()), scopes) {
+		r.Scope = types.StringValue(strings.ReplaceAll(provider.Scope, "+", " "))
+	}
 
 	r.AdminClaimPath = utils.StringPtrToFramework(provider.AdminClaimPath)
 	r.AdminClaimValue = utils.StringPtrToFramework(provider.AdminClaimValue)
 	r.MfaClaimPath = utils.StringPtrToFramework(provider.MfaClaimPath)
 	r.MfaClaimValue = utils.StringPtrToFramework(provider.MfaClaimValue)
 }
+
+// authProviderResourceModelV0 is the state model for schema version 0, the
+// last release before the scopes set and the discovery, write-only secret and
+// TLS settings were added.
+type authProviderResourceModelV0 struct {
+	AdminClaimPath        types.String `tfsdk:"admin_claim_path"`
+	AdminClaimValue       types.String `tfsdk:"admin_claim_value"`
+	AuthorizationEndpoint types.String `tfsdk:"authorization_endpoint"`
+	AutoLink              types.Bool   `tfsdk:"auto_link"`
+	AutoOnboarding        types.Bool   `tfsdk:"auto_onboarding"`
+	ClientId              types.String `tfsdk:"client_id"`
+	ClientSecret          types.String `tfsdk:"client_secret"`
+	ClientSecretBasic     types.Bool   `tfsdk:"client_secret_basic"`
+	ClientSecretPost      types.Bool   `tfsdk:"client_secret_post"`
+	Enabled               types.Bool   `tfsdk:"enabled"`
+	Id                    types.String `tfsdk:"id"`
+	Issuer                types.String `tfsdk:"issuer"`
+	JwksEndpoint          types.String `tfsdk:"jwks_endpoint"`
+	MfaClaimPath          types.String `tfsdk:"mfa_claim_path"`
+	MfaClaimValue         types.String `tfsdk:"mfa_claim_value"`
+	Name                  types.String `tfsdk:"name"`
+	Scope                 types.String `tfsdk:"scope"`
+	TokenEndpoint         types.String `tfsdk:"token_endpoint"`
+	Typ                   types.String `tfsdk:"typ"`
+	UsePkce               types.Bool   `tfsdk:"use_pkce"`
+	UserinfoEndpoint      types.String `tfsdk:"userinfo_endpoint"`
+}
+
+func (r *AuthProviderResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"admin_claim_path":       schema.StringAttribute{Optional: true},
+					"admin_claim_value":      schema.StringAttribute{Optional: true},
+					"authorization_endpoint": schema.StringAttribute{Optional: true},
+					"auto_link":              schema.BoolAttribute{Optional: true, Computed: true},
+					"auto_onboarding":        schema.BoolAttribute{Optional: true, Computed: true},
+					"client_id":              schema.StringAttribute{Required: true},
+					"client_secret":          schema.StringAttribute{Required: true, Sensitive: true},
+					"client_secret_basic":    schema.BoolAttribute{Optional: true, Computed: true},
+					"client_secret_post":     schema.BoolAttribute{Optional: true, Computed: true},
+					"enabled":                schema.BoolAttribute{Optional: true, Computed: true},
+					"id":                     schema.StringAttribute{Computed: true},
+					"issuer":                 schema.StringAttribute{Required: true},
+					"jwks_endpoint":          schema.StringAttribute{Optional: true},
+					"mfa_claim_path":         schema.StringAttribute{Optional: true},
+					"mfa_claim_value":        schema.StringAttribute{Optional: true},
+					"name":                   schema.StringAttribute{Required: true},
+					"scope":                  schema.StringAttribute{Optional: true},
+					"token_endpoint":         schema.StringAttribute{Optional: true},
+					"typ":                    schema.StringAttribute{Required: true},
+					"use_pkce":               schema.BoolAttribute{Optional: true, Computed: true},
+					"userinfo_endpoint":      schema.StringAttribute{Optional: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior authProviderResourceModelV0
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgraded := AuthProviderResourceModel{
+					AdminClaimPath:        prior.AdminClaimPath,
+					AdminClaimValue:       prior.AdminClaimValue,
+					AuthorizationEndpoint: prior.AuthorizationEndpoint,
+					AutoLink:              prior.AutoLink,
+					AutoOnboarding:        prior.AutoOnboarding,
+					ClientId:              prior.ClientId,
+					ClientSecret:          prior.ClientSecret,
+					ClientSecretWo:        types.StringNull(),
+					ClientSecretWoVersion: types.Int64Null(),
+					ClientSecretBasic:     prior.ClientSecretBasic,
+					ClientSecretPost:      prior.ClientSecretPost,
+					DangerAllowInsecure:   types.BoolValue(false),
+					Discover:              types.BoolValue(false),
+					Enabled:               prior.Enabled,
+					ForceDestroy:          types.BoolValue(false),
+					Id:                    prior.Id,
+					Issuer:                prior.Issuer,
+					JwksEndpoint:          prior.JwksEndpoint,
+					MfaClaimPath:          prior.MfaClaimPath,
+					MfaClaimValue:         prior.MfaClaimValue,
+					Name:                  prior.Name,
+					RootPem:               types.StringNull(),
+					Scope:                 prior.Scope,
+					Scopes:                tfutils.StringSliceToSet(rauthy.ParseScope(prior.Scope.ValueString())),
+					TokenEndpoint:         prior.TokenEndpoint,
+					Typ:                   prior.Typ,
+					UsePkce:               prior.UsePkce,
+					UserinfoEndpoint:      prior.UserinfoEndpoint,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &upgraded)...)
+			},
+		},
+	}
+}
@@ -1,14 +1,29 @@
 package auth_provider_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/acctest"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/auth_provider"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccAuthProviderResource(t *testing.T) {
@@ -34,12 +49,18 @@ func TestAccAuthProviderResource(t *testing.T) {
 						tfjsonpath.New("issuer"),
 						knownvalue.StringExact("https://accounts.google.com"),
 					),
+					statecheck.ExpectKnownValue(
+						"rauthy_auth_provider.google",
+						tfjsonpath.New("force_destroy"),
+						knownvalue.Bool(false),
+					),
 				},
 			},
 			{
-				ResourceName:      "rauthy_auth_provider.google",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "rauthy_auth_provider.google",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_secret"},
 			},
 			{
 				Config: testAccAuthProviderResourceConfig("google", "Google 2"),
@@ -55,6 +76,565 @@ func TestAccAuthProviderResource(t *testing.T) {
 	})
 }
 
+func TestAuthProviderResource_DeleteLinkedUsers(t *testing.T) {
+	for _, forceDestroy := range []bool{false, true} {
+		t.Run(fmt.Sprintf("force_destroy=%t", forceDestroy), func(t *testing.T) {
+			var deleted []string
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					fmt.Fprintln(w, `[{"id": "user-1", "email": "alice@example.com"}]`)
+				case http.MethodDelete:
+					deleted = append(deleted, r.URL.Path)
+				}
+			}))
+			defer ts.Close()
+
+			ctx := context.Background()
+			r := auth_provider.NewAuthProviderResource()
+
+			r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{
+				ProviderData: rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret")),
+			}, &fwresource.ConfigureResponse{})
+
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			diags := state.Set(ctx, &auth_provider.AuthProviderResourceModel{
+				Id:           types.StringValue("github"),
+				Name:         types.StringValue("GitHub"),
+				ForceDestroy: types.BoolValue(forceDestroy),
+				Scopes:       types.SetNull(types.StringType),
+			})
+			assert.False(t, diags.HasError(), diags)
+
+			resp := &fwresource.DeleteResponse{}
+			r.Delete(ctx, fwresource.DeleteRequest{State: state}, resp)
+
+			if forceDestroy {
+				assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+				assert.Equal(t, []string{"/auth/v1/providers/github"}, deleted)
+				return
+			}
+
+			assert.True(t, resp.Diagnostics.HasError())
+			assert.Equal(t, "OIDC Provider In Use", resp.Diagnostics.Errors()[0].Summary())
+			assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "alice@example.com")
+			assert.Empty(t, deleted)
+		})
+	}
+}
+
+// Destroying a provider with linked users fails until force_destroy is set.
+// The user given by RAUTHY_TEST_USERNAME is linked to it and unlinked again
+// when the test ends.
+func TestAccAuthProviderResource_ForceDestroy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+
+			if os.Getenv("RAUTHY_TEST_USERNAME") == "" {
+				t.Skip("RAUTHY_TEST_USERNAME must be set to link a user to the provider")
+			}
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			providers, err := acctest.TestAccClient().GetAuthProviders(context.Background())
+			if err != nil {
+				return err
+			}
+
+			for _, provider := range providers {
+				if provider.Name == "Force Destroy" {
+					return fmt.Errorf("expected OIDC provider %s to be deleted", provider.Id)
+				}
+			}
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthProviderResourceForceDestroyConfig(false),
+				Check: func(s *terraform.State) error {
+					return testAccLinkTestUser(t, s.RootModule().Resources["rauthy_auth_provider.github"].Primary.ID)
+				},
+			},
+			{
+				Config:      testAccAuthProviderResourceForceDestroyConfig(false),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`OIDC\s+Provider\s+In\s+Use`),
+			},
+			{
+				Config: testAccAuthProviderResourceForceDestroyConfig(true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("rauthy_auth_provider.github", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				Config:  testAccAuthProviderResourceForceDestroyConfig(true),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+// testAccLinkTestUser links the RAUTHY_TEST_USERNAME user to the provider
+// and unlinks them once the test has finished.
+func testAccLinkTestUser(t *testing.T, providerId string) error {
+	ctx := context.Background()
+	client := acctest.TestAccClient()
+
+	user, err := client.GetUserByEmail(ctx, os.Getenv("RAUTHY_TEST_USERNAME"))
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.LinkUserProvider(ctx, user, providerId, "force-destroy"); err != nil {
+		return err
+	}
+
+	t.Cleanup(func() {
+		if _, err := client.UnlinkUserProvider(context.Background(), user.Id); err != nil {
+			t.Errorf("unable to unlink user %s: %s", user.Email, err)
+		}
+	})
+
+	return nil
+}
+
+func testAccAuthProviderResourceForceDestroyConfig(forceDestroy bool) string {
+	return fmt.Sprintf(`
+resource "rauthy_auth_provider" "github" {
+	name = "Force Destroy"
+	typ = "github"
+	client_id = "force-destroy"
+	client_secret = "github-client-secret"
+	force_destroy = %t
+}
+`, forceDestroy)
+}
+
+func TestAccAuthProviderResource_WriteOnlySecret(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthProviderResourceWriteOnlyConfig(1),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"rauthy_auth_provider.google",
+						tfjsonpath.New("client_secret_wo"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"rauthy_auth_provider.google",
+						tfjsonpath.New("client_secret"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				Config: testAccAuthProviderResourceWriteOnlyConfig(2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("rauthy_auth_provider.google", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccAuthProviderResourceWriteOnlyConfig(version int) string {
+	return fmt.Sprintf(`
+resource "rauthy_auth_provider" "google" {
+	name = "Google Write Only"
+	typ = "google"
+	issuer = "https://accounts.google.com"
+	client_id = "google-write-only"
+	client_secret_wo = "google-client-secret-%[1]d"
+	client_secret_wo_version = %[1]d
+	authorization_endpoint = "https://accounts.google.com/o/oauth2/v2/auth"
+	token_endpoint = "https://oauth2.googleapis.com/token"
+	userinfo_endpoint = "https://openidconnect.googleapis.com/v1/userinfo"
+	jwks_endpoint = "https://www.googleapis.com/oauth2/v3/certs"
+	scope = "openid profile email"
+}
+`, version)
+}
+
+func TestAccAuthProviderResource_ClientSecretRequired(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "rauthy_auth_provider" "invalid" {
+	name = "Missing Secret"
+	typ = "github"
+	client_id = "missing-secret"
+}
+`,
+				ExpectError: regexp.MustCompile("One\\s+of\\s+`client_secret`\\s+and\\s+`client_secret_wo`\\s+must\\s+be\\s+set"),
+			},
+			{
+				Config: `
+resource "rauthy_auth_provider" "invalid" {
+	name = "Both Secrets"
+	typ = "github"
+	client_id = "both-secrets"
+	client_secret = "client-secret"
+	client_secret_wo = "client-secret"
+}
+`,
+				ExpectError: regexp.MustCompile("Only\\s+one\\s+of\\s+`client_secret`\\s+and\\s+`client_secret_wo`\\s+can\\s+be\\s+set"),
+			},
+		},
+	})
+}
+
+func TestAccAuthProviderResource_Discover(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "rauthy_auth_provider" "google" {
+	name = "Google Discovered"
+	typ = "google"
+	issuer = "https://accounts.google.com"
+	client_id = "google-discovered"
+	client_secret = "google-client-secret"
+	scope = "openid profile email"
+	discover = true
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(
+							"rauthy_auth_provider.google",
+							tfjsonpath.New("token_endpoint"),
+							knownvalue.StringExact("https://oauth2.googleapis.com/token"),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"rauthy_auth_provider.google",
+						tfjsonpath.New("jwks_endpoint"),
+						knownvalue.StringExact("https://www.googleapis.com/oauth2/v3/certs"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccAuthProviderResource_DiscoverConflict(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "rauthy_auth_provider" "google" {
+	name = "Google Conflict"
+	typ = "google"
+	issuer = "https://accounts.google.com"
+	client_id = "google-conflict"
+	client_secret = "google-client-secret"
+	token_endpoint = "https://oauth2.googleapis.com/token"
+	discover = true
+}
+`,
+				ExpectError: regexp.MustCompile("is resolved from the issuer"),
+			},
+		},
+	})
+}
+
+func TestAccAuthProviderResource_Preset(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "rauthy_auth_provider" "github" {
+	name = "GitHub Preset"
+	typ = "github"
+	client_id = "github-preset"
+	client_secret = "github-client-secret"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(
+							"rauthy_auth_provider.github",
+							tfjsonpath.New("token_endpoint"),
+							knownvalue.StringExact("https://github.com/login/oauth/access_token"),
+						),
+						plancheck.ExpectKnownValue(
+							"rauthy_auth_provider.github",
+							tfjsonpath.New("client_secret_post"),
+							knownvalue.Bool(true),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"rauthy_auth_provider.github",
+						tfjsonpath.New("issuer"),
+						knownvalue.StringExact("https://github.com"),
+					),
+					statecheck.ExpectKnownValue(
+						"rauthy_auth_provider.github",
+						tfjsonpath.New("scope"),
+						knownvalue.StringExact("read:user user:email"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccAuthProviderResource_RemoveEndpoint(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthProviderResourceCustomConfig(`jwks_endpoint = "https://idp.example.com/jwks"`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"rauthy_auth_provider.custom",
+						tfjsonpath.New("jwks_endpoint"),
+						knownvalue.StringExact("https://idp.example.com/jwks"),
+					),
+				},
+			},
+			{
+				Config: testAccAuthProviderResourceCustomConfig(""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"rauthy_auth_provider.custom",
+						tfjsonpath.New("jwks_endpoint"),
+						knownvalue.StringExact(""),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccAuthProviderResourceCustomConfig(attributes string) string {
+	return fmt.Sprintf(`
+resource "rauthy_auth_provider" "custom" {
+	name = "Custom Endpoints"
+	typ = "custom"
+	issuer = "https://idp.example.com"
+	client_id = "custom-endpoints"
+	client_secret = "client-secret"
+	authorization_endpoint = "https://idp.example.com/authorize"
+	token_endpoint = "https://idp.example.com/token"
+	userinfo_endpoint = "https://idp.example.com/userinfo"
+	scopes = ["openid"]
+	%s
+}
+`, attributes)
+}
+
+func TestAccAuthProviderResource_PresetRules(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAuthProviderResourcePresetConfig(`typ = "facebook"`),
+				ExpectError: regexp.MustCompile(`Provider type "facebook" is not supported`),
+			},
+			{
+				Config:      testAccAuthProviderResourcePresetConfig(`typ = "github"` + "\n" + `discover = true`),
+				ExpectError: regexp.MustCompile(`does not support OIDC discovery`),
+			},
+			{
+				Config:      testAccAuthProviderResourcePresetConfig(`typ = "google"` + "\n" + `scope = "openid profile"`),
+				ExpectError: regexp.MustCompile(`requires the "email" scope`),
+			},
+			{
+				Config:      testAccAuthProviderResourcePresetConfig(`typ = "google"` + "\n" + `scopes = ["openid", "profile"]`),
+				ExpectError: regexp.MustCompile(`requires the "email" scope`),
+			},
+			{
+				Config:      testAccAuthProviderResourcePresetConfig(`typ = "google"` + "\n" + `scope = "openid email"` + "\n" + `scopes = ["openid", "email"]`),
+				ExpectError: regexp.MustCompile("Only one of `scope` and `scopes` can be set"),
+			},
+			{
+				Config:      testAccAuthProviderResourcePresetConfig(`typ = "google"` + "\n" + `admin_claim_path = "$.roles["`),
+				ExpectError: regexp.MustCompile(`invalid claim path`),
+			},
+			{
+				Config:      testAccAuthProviderResourcePresetConfig(`typ = "custom"`),
+				ExpectError: regexp.MustCompile("`issuer` is required"),
+			},
+		},
+	})
+}
+
+func TestAccAuthProviderResource_Scopes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthProviderResourceScopesConfig(`scopes = ["openid", "email", "profile"]`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"rauthy_auth_provider.google",
+						tfjsonpath.New("scopes"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("email"),
+							knownvalue.StringExact("openid"),
+							knownvalue.StringExact("profile"),
+						}),
+					),
+				},
+			},
+			{
+				ResourceName:            "rauthy_auth_provider.google",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
+			{
+				Config: testAccAuthProviderResourceScopesConfig(`scopes = ["profile", "openid", "email"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("rauthy_auth_provider.google", plancheck.ResourceActionNoop),
+					},
+				},
+			},
+			{
+				Config: testAccAuthProviderResourceScopesConfig(`scope = "email openid"`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"rauthy_auth_provider.google",
+						tfjsonpath.New("scopes"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("email"),
+							knownvalue.StringExact("openid"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"rauthy_auth_provider.google",
+						tfjsonpath.New("scope"),
+						knownvalue.StringExact("email openid"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccAuthProviderResource_PlusSeparatedScope(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthProviderResourceScopesConfig(`scope = "openid+email"`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"rauthy_auth_provider.google",
+						tfjsonpath.New("scopes"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("email"),
+							knownvalue.StringExact("openid"),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccAuthProviderResourceScopesConfig(scopes string) string {
+	return fmt.Sprintf(`
+resource "rauthy_auth_provider" "google" {
+	name = "Google Scopes"
+	typ = "google"
+	client_id = "google-scopes"
+	client_secret = "google-client-secret"
+	%s
+}
+`, scopes)
+}
+
+func TestAccAuthProviderResource_InvalidRootPem(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAuthProviderResourcePresetConfig(`typ = "google"` + "\n" + `root_pem = "not a certificate"`),
+				ExpectError: regexp.MustCompile(`no PEM encoded certificate found`),
+			},
+		},
+	})
+}
+
+func TestAuthProviderResource_InsecureWarningUnknownIssuer(t *testing.T) {
+	ctx := context.Background()
+	r := auth_provider.NewAuthProviderResource()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	diags := state.Set(ctx, &auth_provider.AuthProviderResourceModel{
+		Name:                types.StringValue("Insecure"),
+		Typ:                 types.StringValue("custom"),
+		Issuer:              types.StringUnknown(),
+		ClientId:            types.StringValue("insecure"),
+		ClientSecret:        types.StringValue("client-secret"),
+		DangerAllowInsecure: types.BoolValue(true),
+		Scopes:              types.SetNull(types.StringType),
+	})
+	assert.False(t, diags.HasError(), diags)
+
+	resp := &fwresource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: state.Raw}}
+	r.(fwresource.ResourceWithModifyPlan).ModifyPlan(ctx, fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw},
+		State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: state.Raw},
+	}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Len(t, resp.Diagnostics.Warnings(), 1)
+	assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), "TLS certificate of the upstream provider,")
+}
+
+func testAccAuthProviderResourcePresetConfig(attributes string) string {
+	return fmt.Sprintf(`
+resource "rauthy_auth_provider" "invalid" {
+	name = "Invalid Preset"
+	client_id = "invalid-preset"
+	client_secret = "client-secret"
+	%s
+}
+`, attributes)
+}
+
 func testAccAuthProviderResourceConfig(id string, name string) string {
 	return fmt.Sprintf(`
 resource "rauthy_auth_provider" "google" {
@@ -0,0 +1,199 @@
+package auth_provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/utils"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/tfutils"
+)
+
+var _ datasource.DataSource = &AuthProvidersDataSource{}
+
+func NewAuthProvidersDataSource() datasource.DataSource {
+	return &AuthProvidersDataSource{}
+}
+
+type AuthProvidersDataSource struct {
+	client *rauthy.Client
+}
+
+func (d *AuthProvidersDataSource) SetClient(c *rauthy.Client) {
+	d.client = c
+}
+
+type AuthProvidersDataSourceModel struct {
+	Enabled   types.Bool                             `tfsdk:"enabled"`
+	Typ       types.String                           `tfsdk:"typ"`
+	Ids       types.List                             `tfsdk:"ids"`
+	Providers []AuthProvidersDataSourceProviderModel `tfsdk:"providers"`
+}
+
+type AuthProvidersDataSourceProviderModel struct {
+	Id                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Typ                   types.String `tfsdk:"typ"`
+	Enabled               types.Bool   `tfsdk:"enabled"`
+	Issuer                types.String `tfsdk:"issuer"`
+	ClientId              types.String `tfsdk:"client_id"`
+	AuthorizationEndpoint types.String `tfsdk:"authorization_endpoint"`
+	TokenEndpoint         types.String `tfsdk:"token_endpoint"`
+	UserinfoEndpoint      types.String `tfsdk:"userinfo_endpoint"`
+	JwksEndpoint          types.String `tfsdk:"jwks_endpoint"`
+	Scope                 types.String `tfsdk:"scope"`
+	Scopes                types.Set    `tfsdk:"scopes"`
+	UsePkce               types.Bool   `tfsdk:"use_pkce"`
+}
+
+func (d *AuthProvidersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_providers"
+}
+
+func (d *AuthProvidersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Auth Providers data source, sorted by name. All filters are optional and combined with AND",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Only return providers with this enabled state",
+				Optional:            true,
+			},
+			"typ": schema.StringAttribute{
+				MarkdownDescription: "Only return providers of this type, e.g. `google`",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the matching providers",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"providers": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching providers, without their client secrets",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Provider ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Provider Name",
+							Computed:            true,
+						},
+						"typ": schema.StringAttribute{
+							MarkdownDescription: "Type",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the provider is enabled",
+							Computed:            true,
+						},
+						"issuer": schema.StringAttribute{
+							MarkdownDescription: "Provider Issuer",
+							Computed:            true,
+						},
+						"client_id": schema.StringAttribute{
+							MarkdownDescription: "Client ID",
+							Computed:            true,
+						},
+						"authorization_endpoint": schema.StringAttribute{
+							MarkdownDescription: "Authorization Endpoint",
+							Computed:            true,
+						},
+						"token_endpoint": schema.StringAttribute{
+							MarkdownDescription: "Token Endpoint",
+							Computed:            true,
+						},
+						"userinfo_endpoint": schema.StringAttribute{
+							MarkdownDescription: "Userinfo Endpoint",
+							Computed:            true,
+						},
+						"jwks_endpoint": schema.StringAttribute{
+							MarkdownDescription: "JWKS Endpoint",
+							Computed:            true,
+						},
+						"scope": schema.StringAttribute{
+							MarkdownDescription: "Space separated scopes",
+							Computed:            true,
+						},
+						"scopes": schema.SetAttribute{
+							MarkdownDescription: "Scopes requested from the provider",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"use_pkce": schema.BoolAttribute{
+							MarkdownDescription: "Use PKCE",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AuthProvidersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	utils.ConfigureDataSource(ctx, req, resp, d)
+}
+
+func (d *AuthProvidersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AuthProvidersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	providers, err := d.client.GetAuthProviders(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OIDC providers, got error: %s", err))
+		return
+	}
+
+	// Keep the order independent of the API so plans don't churn
+	slices.SortFunc(providers, func(a, b rauthy.AuthProvider) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Id, b.Id))
+	})
+
+	ids := []string{}
+	data.Providers = []AuthProvidersDataSourceProviderModel{}
+
+	for _, p := range providers {
+		if !data.Enabled.IsNull() && p.Enabled != data.Enabled.ValueBool() {
+			continue
+		}
+
+		if !data.Typ.IsNull() && p.Typ != data.Typ.ValueString() {
+			continue
+		}
+
+		ids = append(ids, p.Id)
+		data.Providers = append(data.Providers, AuthProvidersDataSourceProviderModel{
+			Id:                    types.StringValue(p.Id),
+			Name:                  types.StringValue(p.Name),
+			Typ:                   types.StringValue(p.Typ),
+			Enabled:               types.BoolValue(p.Enabled),
+			Issuer:                types.StringValue(p.Issuer),
+			ClientId:              types.StringValue(p.ClientId),
+			AuthorizationEndpoint: types.StringValue(p.AuthorizationEndpoint),
+			TokenEndpoint:         types.StringValue(p.TokenEndpoint),
+			UserinfoEndpoint:      types.StringValue(p.UserinfoEndpoint),
+			JwksEndpoint:          types.StringValue(p.JwksEndpoint),
+			Scope:                 types.StringValue(strings.ReplaceAll(p.Scope, "+", " ")),
+			Scopes:                tfutils.StringSliceToSet(rauthy.ParseScope(p.Scope)),
+			UsePkce:               types.BoolValue(p.UsePkce),
+		})
+	}
+
+	data.Ids = tfutils.StringSliceToList(ids)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
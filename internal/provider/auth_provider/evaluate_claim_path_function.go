@@ -0,0 +1,87 @@
+package auth_provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+)
+
+var _ function.Function = &EvaluateClaimPathFunction{}
+
+func NewEvaluateClaimPathFunction() function.Function {
+	return &EvaluateClaimPathFunction{}
+}
+
+// EvaluateClaimPathFunction previews what an `admin_claim_path` or
+// `mfa_claim_path` selects, so mappings can be tested without a login.
+type EvaluateClaimPathFunction struct{}
+
+func (f *EvaluateClaimPathFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "evaluate_claim_path"
+}
+
+func (f *EvaluateClaimPathFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Evaluate a claim path against ID token claims",
+		MarkdownDescription: "Returns the values a `rauthy_auth_provider` claim path selects from the claims of an upstream ID token. " +
+			"Strings are returned as is, other values JSON encoded",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "path",
+				MarkdownDescription: "Claim path, e.g. `$.roles.*`",
+			},
+			function.StringParameter{
+				Name:                "claims_json",
+				MarkdownDescription: "JSON encoded claims of the ID token",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *EvaluateClaimPathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var path, claimsJson string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &path, &claimsJson))
+
+	if resp.Error != nil {
+		return
+	}
+
+	claimPath, err := rauthy.ParseClaimPath(path)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	var claims any
+	if err := json.Unmarshal([]byte(claimsJson), &claims); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, "Invalid claims JSON: "+err.Error())
+		return
+	}
+
+	values := []string{}
+
+	for _, value := range claimPath.Evaluate(claims) {
+		if s, ok := value.(string); ok {
+			values = append(values, s)
+			continue
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			resp.Error = function.NewFuncError("Unable to encode claim value: " + err.Error())
+			return
+		}
+
+		values = append(values, string(encoded))
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, values))
+}
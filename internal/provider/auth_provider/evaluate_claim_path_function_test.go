@@ -0,0 +1,69 @@
+package auth_provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/acctest"
+)
+
+func TestAccEvaluateClaimPathFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+locals {
+	claims = jsonencode({
+		roles = ["user", "admin"]
+		org   = { teams = [{ name = "infra", admin = true }] }
+	})
+}
+
+output "roles" {
+	value = provider::rauthy::evaluate_claim_path("$.roles.*", local.claims)
+}
+
+output "admin" {
+	value = provider::rauthy::evaluate_claim_path("$..admin", local.claims)
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("roles", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("user"),
+						knownvalue.StringExact("admin"),
+					})),
+					statecheck.ExpectKnownOutputValue("admin", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("true"),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccEvaluateClaimPathFunction_InvalidPath(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "roles" {
+	value = provider::rauthy::evaluate_claim_path("$.cognito:groups", "{}")
+}
+`,
+				ExpectError: regexp.MustCompile(`invalid claim path at position 9`),
+			},
+		},
+	})
+}
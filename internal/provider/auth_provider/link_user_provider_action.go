@@ -0,0 +1,175 @@
+package auth_provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/utils"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+)
+
+var _ action.Action = &LinkUserProviderAction{}
+var _ action.ActionWithConfigure = &LinkUserProviderAction{}
+var _ action.ActionWithValidateConfig = &LinkUserProviderAction{}
+
+func NewLinkUserProviderAction() action.Action {
+	return &LinkUserProviderAction{}
+}
+
+type LinkUserProviderAction struct {
+	client *rauthy.Client
+}
+
+func (a *LinkUserProviderAction) SetClient(c *rauthy.Client) {
+	a.client = c
+}
+
+type LinkUserProviderActionModel struct {
+	UserId        types.String `tfsdk:"user_id"`
+	Email         types.String `tfsdk:"email"`
+	ProviderId    types.String `tfsdk:"provider_id"`
+	FederationUid types.String `tfsdk:"federation_uid"`
+}
+
+func (a *LinkUserProviderAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_link_user_provider"
+}
+
+func (a *LinkUserProviderAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Links a user to an upstream auth provider, so they log in through it. " +
+			"Fails when the user is linked to another provider, unlink them with `rauthy_unlink_user_provider` first",
+
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "User ID. Exactly one of `user_id` or `email` must be set",
+				Optional:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "User email. Exactly one of `user_id` or `email` must be set",
+				Optional:            true,
+			},
+			"provider_id": schema.StringAttribute{
+				MarkdownDescription: "Auth provider ID",
+				Required:            true,
+			},
+			"federation_uid": schema.StringAttribute{
+				MarkdownDescription: "Subject of the user at the provider, the `sub` claim of its ID tokens. " +
+					"Required when the user has never been federated, otherwise the one Rauthy has is kept",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (a *LinkUserProviderAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	utils.ConfigureAction(ctx, req, resp, a)
+}
+
+func (a *LinkUserProviderAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data LinkUserProviderActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateUserSelector(data.UserId, data.Email)...)
+}
+
+func (a *LinkUserProviderAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data LinkUserProviderActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := findUser(ctx, a.client, data.UserId, data.Email)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+		return
+	}
+
+	providerId := data.ProviderId.ValueString()
+
+	provider, err := a.client.GetAuthProvider(ctx, providerId)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OIDC provider, got error: %s", err))
+		return
+	}
+
+	if user.AuthProviderId != nil && *user.AuthProviderId == providerId {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("User %s is already linked to auth provider %s", user.Email, provider.Name),
+		})
+
+		return
+	}
+
+	if user.AuthProviderId != nil {
+		resp.Diagnostics.AddError(
+			"User Linked Elsewhere",
+			fmt.Sprintf(
+				"User %s is linked to auth provider %s and cannot be linked to %s. "+
+					"Unlink them with the rauthy_unlink_user_provider action first.",
+				user.Email,
+				authProviderName(ctx, a.client, *user.AuthProviderId),
+				provider.Name,
+			),
+		)
+
+		return
+	}
+
+	if _, err := a.client.LinkUserProvider(ctx, user, providerId, data.FederationUid.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to link user %s to OIDC provider, got error: %s", user.Email, err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Linked user %s to auth provider %s", user.Email, provider.Name),
+	})
+}
+
+// validateUserSelector checks that exactly one way to select the user is
+// configured.
+func validateUserSelector(userId types.String, email types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if userId.IsNull() == email.IsNull() {
+		diags.AddAttributeError(
+			path.Root("user_id"),
+			"Invalid Attribute Combination",
+			"Exactly one of `user_id` or `email` must be set",
+		)
+	}
+
+	return diags
+}
+
+func findUser(ctx context.Context, client *rauthy.Client, userId types.String, email types.String) (*rauthy.User, error) {
+	if !userId.IsNull() {
+		return client.GetUser(ctx, userId.ValueString())
+	}
+
+	return client.GetUserByEmail(ctx, email.ValueString())
+}
+
+// authProviderName names the provider in messages, falling back to its ID
+// when it cannot be read.
+func authProviderName(ctx context.Context, client *rauthy.Client, id string) string {
+	provider, err := client.GetAuthProvider(ctx, id)
+	if err != nil {
+		return id
+	}
+
+	return fmt.Sprintf("%s (%s)", provider.Name, id)
+}
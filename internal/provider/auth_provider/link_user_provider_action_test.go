@@ -0,0 +1,229 @@
+package auth_provider_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/acctest"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/auth_provider"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+	"github.com/stretchr/testify/assert"
+)
+
+// testUserProviderServer serves user and the google and github providers,
+// recording the user patches it receives.
+func testUserProviderServer(t *testing.T, user string, patches *[]rauthy.UserPatch) *rauthy.Client {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/v1/users/email/alice@example.com":
+			fmt.Fprintln(w, user)
+		case r.Method == http.MethodPost && r.URL.Path == "/auth/v1/providers":
+			fmt.Fprintln(w, `[{"id": "google", "name": "Google"}, {"id": "github", "name": "GitHub"}]`)
+		case r.Method == http.MethodPatch && r.URL.Path == "/auth/v1/users/user-1":
+			var patch rauthy.UserPatch
+			json.NewDecoder(r.Body).Decode(&patch)
+			*patches = append(*patches, patch)
+			fmt.Fprintln(w, user)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(ts.Close)
+
+	return rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+}
+
+// testInvokeUserProviderAction invokes a on alice@example.com and returns the
+// progress messages it sent.
+func testInvokeUserProviderAction(t *testing.T, a action.Action, client *rauthy.Client, attributes map[string]string) ([]string, *action.InvokeResponse) {
+	ctx := context.Background()
+
+	a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{ProviderData: client}, &action.ConfigureResponse{})
+
+	schemaResp := &action.SchemaResponse{}
+	a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(tftypes.String, nil)
+	}
+
+	values["email"] = tftypes.NewValue(tftypes.String, "alice@example.com")
+	for name, value := range attributes {
+		values[name] = tftypes.NewValue(tftypes.String, value)
+	}
+
+	var messages []string
+	resp := &action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			messages = append(messages, event.Message)
+		},
+	}
+
+	a.Invoke(ctx, action.InvokeRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, resp)
+
+	return messages, resp
+}
+
+func TestLinkUserProviderAction_Link(t *testing.T) {
+	var patches []rauthy.UserPatch
+
+	client := testUserProviderServer(t, `{"id": "user-1", "email": "alice@example.com"}`, &patches)
+
+	messages, resp := testInvokeUserProviderAction(t, auth_provider.NewLinkUserProviderAction(), client, map[string]string{
+		"provider_id":    "google",
+		"federation_uid": "1234",
+	})
+
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, []string{"Linked user alice@example.com to auth provider Google"}, messages)
+	assert.Equal(t, []rauthy.UserPatch{{
+		Put: []rauthy.UserPatchValue{
+			{Key: "auth_provider_id", Value: "google"},
+			{Key: "federation_uid", Value: "1234"},
+		},
+		Del: []string{},
+	}}, patches)
+}
+
+func TestLinkUserProviderAction_FederationUidRequired(t *testing.T) {
+	var patches []rauthy.UserPatch
+
+	client := testUserProviderServer(t, `{"id": "user-1", "email": "alice@example.com"}`, &patches)
+
+	_, resp := testInvokeUserProviderAction(t, auth_provider.NewLinkUserProviderAction(), client, map[string]string{
+		"provider_id": "google",
+	})
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "federation_uid is required")
+	assert.Empty(t, patches)
+}
+
+func TestLinkUserProviderAction_LinkedElsewhere(t *testing.T) {
+	var patches []rauthy.UserPatch
+
+	client := testUserProviderServer(t, `{"id": "user-1", "email": "alice@example.com", "auth_provider_id": "github", "federation_uid": "1234"}`, &patches)
+
+	_, resp := testInvokeUserProviderAction(t, auth_provider.NewLinkUserProviderAction(), client, map[string]string{
+		"provider_id": "google",
+	})
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "User Linked Elsewhere", resp.Diagnostics.Errors()[0].Summary())
+	assert.Equal(t,
+		"User alice@example.com is linked to auth provider GitHub (github) and cannot be linked to Google. "+
+			"Unlink them with the rauthy_unlink_user_provider action first.",
+		resp.Diagnostics.Errors()[0].Detail(),
+	)
+	assert.Empty(t, patches)
+}
+
+func TestUnlinkUserProviderAction_Unlink(t *testing.T) {
+	var patches []rauthy.UserPatch
+
+	client := testUserProviderServer(t, `{"id": "user-1", "email": "alice@example.com", "auth_provider_id": "google", "federation_uid": "1234"}`, &patches)
+
+	messages, resp := testInvokeUserProviderAction(t, auth_provider.NewUnlinkUserProviderAction(), client, map[string]string{
+		"provider_id": "google",
+	})
+
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, []string{"Unlinked user alice@example.com from auth provider Google (google)"}, messages)
+	assert.Equal(t, []rauthy.UserPatch{{
+		Put: []rauthy.UserPatchValue{},
+		Del: []string{"auth_provider_id", "federation_uid"},
+	}}, patches)
+}
+
+func TestAccLinkUserProviderAction_UnknownUser(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLinkUserProviderActionConfig,
+				ExpectError: regexp.MustCompile(`Unable to read user`),
+			},
+		},
+	})
+}
+
+func TestAccLinkUserProviderAction_InvalidUser(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+action "rauthy_unlink_user_provider" "invalid" {
+	config {
+		user_id = "user-1"
+		email = "user@example.com"
+		provider_id = "google"
+	}
+}
+
+resource "terraform_data" "migration" {
+	input = "1"
+
+	lifecycle {
+		action_trigger {
+			events  = [after_create]
+			actions = [action.rauthy_unlink_user_provider.invalid]
+		}
+	}
+}
+`,
+				ExpectError: regexp.MustCompile("Exactly one of `user_id` or `email` must be set"),
+			},
+		},
+	})
+}
+
+const testAccLinkUserProviderActionConfig = `
+resource "rauthy_auth_provider" "google" {
+	name = "Google Link"
+	typ = "google"
+	client_id = "google-link"
+	client_secret = "google-client-secret"
+}
+
+action "rauthy_link_user_provider" "google" {
+	config {
+		email = "nobody@example.com"
+		provider_id = rauthy_auth_provider.google.id
+	}
+}
+
+resource "terraform_data" "migration" {
+	input = "1"
+
+	lifecycle {
+		action_trigger {
+			events  = [after_create]
+			actions = [action.rauthy_link_user_provider.google]
+		}
+	}
+}
+`
@@ -0,0 +1,125 @@
+package auth_provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/utils"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+)
+
+var _ action.Action = &UnlinkUserProviderAction{}
+var _ action.ActionWithConfigure = &UnlinkUserProviderAction{}
+var _ action.ActionWithValidateConfig = &UnlinkUserProviderAction{}
+
+func NewUnlinkUserProviderAction() action.Action {
+	return &UnlinkUserProviderAction{}
+}
+
+type UnlinkUserProviderAction struct {
+	client *rauthy.Client
+}
+
+func (a *UnlinkUserProviderAction) SetClient(c *rauthy.Client) {
+	a.client = c
+}
+
+type UnlinkUserProviderActionModel struct {
+	UserId     types.String `tfsdk:"user_id"`
+	Email      types.String `tfsdk:"email"`
+	ProviderId types.String `tfsdk:"provider_id"`
+}
+
+func (a *UnlinkUserProviderAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unlink_user_provider"
+}
+
+func (a *UnlinkUserProviderAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Removes the link between a user and an upstream auth provider. " +
+			"The user needs a password or passkey to log in afterwards. Fails when the user is linked to another provider",
+
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "User ID. Exactly one of `user_id` or `email` must be set",
+				Optional:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "User email. Exactly one of `user_id` or `email` must be set",
+				Optional:            true,
+			},
+			"provider_id": schema.StringAttribute{
+				MarkdownDescription: "Auth provider ID the user is expected to be linked to",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (a *UnlinkUserProviderAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	utils.ConfigureAction(ctx, req, resp, a)
+}
+
+func (a *UnlinkUserProviderAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data UnlinkUserProviderActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateUserSelector(data.UserId, data.Email)...)
+}
+
+func (a *UnlinkUserProviderAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data UnlinkUserProviderActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := findUser(ctx, a.client, data.UserId, data.Email)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+		return
+	}
+
+	providerId := data.ProviderId.ValueString()
+
+	if user.AuthProviderId == nil {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("User %s is not linked to any auth provider", user.Email),
+		})
+
+		return
+	}
+
+	if *user.AuthProviderId != providerId {
+		resp.Diagnostics.AddError(
+			"User Linked Elsewhere",
+			fmt.Sprintf(
+				"User %s is linked to auth provider %s, not %s. It was left unchanged.",
+				user.Email,
+				authProviderName(ctx, a.client, *user.AuthProviderId),
+				authProviderName(ctx, a.client, providerId),
+			),
+		)
+
+		return
+	}
+
+	if _, err := a.client.UnlinkUserProvider(ctx, user.Id); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unlink user %s from OIDC provider, got error: %s", user.Email, err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Unlinked user %s from auth provider %s", user.Email, authProviderName(ctx, a.client, providerId)),
+	})
+}
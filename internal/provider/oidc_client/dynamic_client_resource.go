@@ -0,0 +1,302 @@
+package oidc_client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/utils"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/tfutils"
+)
+
+var _ resource.Resource = &DynamicClientResource{}
+var _ resource.ResourceWithImportState = &DynamicClientResource{}
+
+func NewDynamicClientResource() resource.Resource {
+	return &DynamicClientResource{}
+}
+
+type DynamicClientResource struct {
+	client *rauthy.Client
+}
+
+func (r *DynamicClientResource) SetClient(c *rauthy.Client) {
+	r.client = c
+}
+
+type DynamicClientResourceModel struct {
+	Id                       types.String `tfsdk:"id"`
+	RegistrationToken        types.String `tfsdk:"registration_token"`
+	RedirectUris             types.Set    `tfsdk:"redirect_uris"`
+	PostLogoutRedirectUri    types.String `tfsdk:"post_logout_redirect_uri"`
+	GrantTypes               types.Set    `tfsdk:"grant_types"`
+	ClientName               types.String `tfsdk:"client_name"`
+	ClientUri                types.String `tfsdk:"client_uri"`
+	Contacts                 types.Set    `tfsdk:"contacts"`
+	TokenEndpointAuthMethod  types.String `tfsdk:"token_endpoint_auth_method"`
+	IdTokenSignedResponseAlg types.String `tfsdk:"id_token_signed_response_alg"`
+	ClientSecret             types.String `tfsdk:"client_secret"`
+	RegistrationAccessToken  types.String `tfsdk:"registration_access_token"`
+	RegistrationClientUri    types.String `tfsdk:"registration_client_uri"`
+}
+
+func (r *DynamicClientResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dynamic_client"
+}
+
+func (r *DynamicClientResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Client registered through OpenID dynamic client registration (RFC 7591). " +
+			"It is managed with its registration access token and does not need an admin API key. " +
+			"On destroy it is deleted through the registration endpoint, when Rauthy does not support that " +
+			"it is deleted through the admin API instead, which needs the provider's API key",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Client ID assigned by Rauthy",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"registration_token": schema.StringAttribute{
+				MarkdownDescription: "Initial access token, required when Rauthy is configured with `DYN_CLIENT_REG_TOKEN`. " +
+					"Only used to register the client, later changes have no effect",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"redirect_uris": schema.SetAttribute{
+				MarkdownDescription: "Client redirect URIs",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"post_logout_redirect_uri": schema.StringAttribute{
+				MarkdownDescription: "Client post logout redirect URI",
+				Optional:            true,
+			},
+			"grant_types": schema.SetAttribute{
+				MarkdownDescription: "Client grant types",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_name": schema.StringAttribute{
+				MarkdownDescription: "Client name",
+				Optional:            true,
+			},
+			"client_uri": schema.StringAttribute{
+				MarkdownDescription: "Client URI",
+				Optional:            true,
+			},
+			"contacts": schema.SetAttribute{
+				MarkdownDescription: "Client contacts",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"token_endpoint_auth_method": schema.StringAttribute{
+				MarkdownDescription: "Token endpoint auth method, e.g. `client_secret_basic` or `none`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id_token_signed_response_alg": schema.StringAttribute{
+				MarkdownDescription: "ID token algorithm",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "Client secret, empty for public clients",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"registration_access_token": schema.StringAttribute{
+				MarkdownDescription: "Registration access token used to read, update and delete the client",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"registration_client_uri": schema.StringAttribute{
+				MarkdownDescription: "Client configuration endpoint",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DynamicClientResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	utils.ConfigureProvider(ctx, req, resp, r)
+}
+
+func (r *DynamicClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model DynamicClientResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.RegisterDynamicClient(ctx, model.RegistrationToken.ValueString(), model.ToApi())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to register dynamic client, got error: %s", err))
+		return
+	}
+
+	model.FromApiResource(client)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *DynamicClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model DynamicClientResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.GetDynamicClient(ctx, model.Id.ValueString(), model.RegistrationAccessToken.ValueString())
+	if rauthy.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dynamic client, got error: %s", err))
+		return
+	}
+
+	model.FromApiResource(client)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *DynamicClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model, state DynamicClientResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.ClientSecret = state.ClientSecret
+	model.RegistrationAccessToken = state.RegistrationAccessToken
+
+	client, err := r.client.UpdateDynamicClient(ctx, state.Id.ValueString(), state.RegistrationAccessToken.ValueString(), model.ToApi())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update dynamic client, got error: %s", err))
+		return
+	}
+
+	model.FromApiResource(client)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *DynamicClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model DynamicClientResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteDynamicClient(ctx, model.Id.ValueString(), model.RegistrationAccessToken.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete dynamic client, got error: %s", err))
+		return
+	}
+}
+
+func (r *DynamicClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, token, found := strings.Cut(req.ID, "/")
+
+	if !found {
+		resp.Diagnostics.AddError("Client Error", "ID format is invalid, expected <client_id>/<registration_access_token>")
+		return
+	}
+
+	client, err := r.client.GetDynamicClient(ctx, id, token)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import dynamic client, got error: %s", err))
+		return
+	}
+
+	model := DynamicClientResourceModel{
+		RegistrationAccessToken: types.StringValue(token),
+		ClientSecret:            types.StringNull(),
+	}
+	model.FromApiResource(client)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *DynamicClientResourceModel) ToApi() *rauthy.DynamicClientRequest {
+	return &rauthy.DynamicClientRequest{
+		RedirectUris:             tfutils.SetToStringSlice(r.RedirectUris),
+		PostLogoutRedirectUri:    r.PostLogoutRedirectUri.ValueString(),
+		GrantTypes:               tfutils.SetToStringSlice(r.GrantTypes),
+		ClientName:               r.ClientName.ValueString(),
+		ClientUri:                r.ClientUri.ValueString(),
+		Contacts:                 tfutils.SetToStringSlice(r.Contacts),
+		TokenEndpointAuthMethod:  r.TokenEndpointAuthMethod.ValueString(),
+		IdTokenSignedResponseAlg: r.IdTokenSignedResponseAlg.ValueString(),
+	}
+}
+
+func (r *DynamicClientResourceModel) FromApiResource(client *rauthy.DynamicClient) {
+	r.Id = types.StringValue(client.ClientId)
+	r.RedirectUris = tfutils.StringSliceToSet(client.RedirectUris)
+	r.PostLogoutRedirectUri = utils.StringPtrToFramework(utils.EmptyToNil(client.PostLogoutRedirectUri))
+	r.GrantTypes = tfutils.StringSliceToSet(client.GrantTypes)
+	r.ClientName = utils.StringPtrToFramework(utils.EmptyToNil(client.ClientName))
+	r.ClientUri = utils.StringPtrToFramework(utils.EmptyToNil(client.ClientUri))
+	r.TokenEndpointAuthMethod = types.StringValue(client.TokenEndpointAuthMethod)
+	r.IdTokenSignedResponseAlg = types.StringValue(client.IdTokenSignedResponseAlg)
+
+	if len(client.Contacts) > 0 {
+		r.Contacts = tfutils.StringSliceToSet(client.Contacts)
+	} else {
+		r.Contacts = types.SetNull(types.StringType)
+	}
+
+	// The secret and registration access token are only returned when they
+	// are issued or rotated, keep the known values otherwise
+	if client.ClientSecret != "" || r.ClientSecret.IsUnknown() {
+		r.ClientSecret = types.StringValue(client.ClientSecret)
+	}
+
+	if client.RegistrationAccessToken != "" {
+		r.RegistrationAccessToken = types.StringValue(client.RegistrationAccessToken)
+	}
+
+	if client.RegistrationClientUri != "" || r.RegistrationClientUri.IsUnknown() || r.RegistrationClientUri.IsNull() {
+		r.RegistrationClientUri = types.StringValue(client.RegistrationClientUri)
+	}
+}
@@ -0,0 +1,74 @@
+package oidc_client_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/acctest"
+)
+
+func TestAccDynamicClientResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDynamicClientResourceConfig("Partner"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"rauthy_dynamic_client.partner",
+						tfjsonpath.New("client_name"),
+						knownvalue.StringExact("Partner"),
+					),
+					statecheck.ExpectKnownValue(
+						"rauthy_dynamic_client.partner",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"rauthy_dynamic_client.partner",
+						tfjsonpath.New("registration_access_token"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			{
+				ResourceName:      "rauthy_dynamic_client.partner",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					attributes := s.RootModule().Resources["rauthy_dynamic_client.partner"].Primary.Attributes
+
+					return fmt.Sprintf("%s/%s", attributes["id"], attributes["registration_access_token"]), nil
+				},
+				ImportStateVerifyIgnore: []string{
+					"client_secret",
+				},
+			},
+			{
+				Config: testAccDynamicClientResourceConfig("Partner 2"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"rauthy_dynamic_client.partner",
+						tfjsonpath.New("client_name"),
+						knownvalue.StringExact("Partner 2"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccDynamicClientResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "rauthy_dynamic_client" "partner" {
+	client_name = %[1]q
+	redirect_uris = ["http://localhost/callback"]
+}
+`, name)
+}
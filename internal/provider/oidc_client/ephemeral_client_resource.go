@@ -0,0 +1,264 @@
+package oidc_client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/tfutils"
+)
+
+var _ resource.Resource = &EphemeralClientResource{}
+var _ resource.ResourceWithValidateConfig = &EphemeralClientResource{}
+var _ resource.ResourceWithModifyPlan = &EphemeralClientResource{}
+
+func NewEphemeralClientResource() resource.Resource {
+	return &EphemeralClientResource{}
+}
+
+// EphemeralClientResource manages the metadata document of a Rauthy
+// ephemeral client. Rauthy never stores these clients, it fetches the
+// document from the client ID URL on login, so nothing is sent to the API.
+type EphemeralClientResource struct{}
+
+type EphemeralClientResourceModel struct {
+	Id                           types.String `tfsdk:"id"`
+	ClientName                   types.String `tfsdk:"client_name"`
+	ClientUri                    types.String `tfsdk:"client_uri"`
+	Contacts                     types.Set    `tfsdk:"contacts"`
+	RedirectUris                 types.Set    `tfsdk:"redirect_uris"`
+	PostLogoutRedirectUris       types.Set    `tfsdk:"post_logout_redirect_uris"`
+	GrantTypes                   types.Set    `tfsdk:"grant_types"`
+	DefaultMaxAge                types.Int64  `tfsdk:"default_max_age"`
+	RequireAuthTime              types.Bool   `tfsdk:"require_auth_time"`
+	AccessTokenSignedResponseAlg types.String `tfsdk:"access_token_signed_response_alg"`
+	IdTokenSignedResponseAlg     types.String `tfsdk:"id_token_signed_response_alg"`
+	MetadataDocument             types.String `tfsdk:"metadata_document"`
+}
+
+func (r *EphemeralClientResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ephemeral_client"
+}
+
+func (r *EphemeralClientResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Ephemeral client identified by the URL of its metadata document. " +
+			"Nothing is created in Rauthy: host `metadata_document` at `id` and enable `ENABLE_EPHEMERAL_CLIENTS`. " +
+			"Ephemeral clients are always public, so they have no secret",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Client ID, the https URL the metadata document is served from",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_name": schema.StringAttribute{
+				MarkdownDescription: "Client name",
+				Optional:            true,
+			},
+			"client_uri": schema.StringAttribute{
+				MarkdownDescription: "Client URI",
+				Optional:            true,
+			},
+			"contacts": schema.SetAttribute{
+				MarkdownDescription: "Client contacts",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"redirect_uris": schema.SetAttribute{
+				MarkdownDescription: "Client redirect URIs",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"post_logout_redirect_uris": schema.SetAttribute{
+				MarkdownDescription: "Client post logout redirect URIs",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"grant_types": schema.SetAttribute{
+				MarkdownDescription: "Grant types, only `authorization_code` and `refresh_token` are supported",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"default_max_age": schema.Int64Attribute{
+				MarkdownDescription: "Maximum authentication age in seconds",
+				Optional:            true,
+			},
+			"require_auth_time": schema.BoolAttribute{
+				MarkdownDescription: "Require the `auth_time` claim in ID tokens",
+				Optional:            true,
+			},
+			"access_token_signed_response_alg": schema.StringAttribute{
+				MarkdownDescription: "Access token algorithm",
+				Optional:            true,
+			},
+			"id_token_signed_response_alg": schema.StringAttribute{
+				MarkdownDescription: "ID token algorithm",
+				Optional:            true,
+			},
+			"metadata_document": schema.StringAttribute{
+				MarkdownDescription: "JSON metadata document to serve at `id`",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *EphemeralClientResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model EphemeralClientResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() || !model.isKnown() {
+		return
+	}
+
+	err := model.ToApi().Validate()
+	if err == nil {
+		return
+	}
+
+	errs := []error{err}
+
+	var joined interface{ Unwrap() []error }
+	if errors.As(err, &joined) {
+		errs = joined.Unwrap()
+	}
+
+	// The document fields share their names with the attributes, except for
+	// client_id which is the resource ID
+	for _, err := range errs {
+		attribute := path.Root("id")
+
+		var fieldErr *rauthy.FieldError
+		if errors.As(err, &fieldErr) && fieldErr.Field != "client_id" {
+			attribute = path.Root(fieldErr.Field)
+		}
+
+		resp.Diagnostics.AddAttributeError(attribute, "Invalid Ephemeral Client", err.Error())
+	}
+}
+
+func (r *EphemeralClientResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan EphemeralClientResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() || !plan.isKnown() {
+		return
+	}
+
+	// Render the document at plan time so it can be uploaded in the same apply
+	resp.Diagnostics.Append(plan.renderDocument()...)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *EphemeralClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model EphemeralClientResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(model.renderDocument()...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *EphemeralClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model EphemeralClientResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *EphemeralClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model EphemeralClientResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(model.renderDocument()...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *EphemeralClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *EphemeralClientResourceModel) isKnown() bool {
+	for _, v := range []interface{ IsUnknown() bool }{
+		r.Id, r.ClientName, r.ClientUri, r.Contacts, r.RedirectUris, r.PostLogoutRedirectUris, r.GrantTypes,
+		r.DefaultMaxAge, r.RequireAuthTime, r.AccessTokenSignedResponseAlg, r.IdTokenSignedResponseAlg,
+	} {
+		if v.IsUnknown() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *EphemeralClientResourceModel) renderDocument() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	document, err := json.Marshal(r.ToApi())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to render metadata document, got error: %s", err))
+		return diags
+	}
+
+	r.MetadataDocument = types.StringValue(string(document))
+
+	return diags
+}
+
+func (r *EphemeralClientResourceModel) ToApi() rauthy.EphemeralClient {
+	client := rauthy.EphemeralClient{
+		ClientId:                     r.Id.ValueString(),
+		ClientName:                   r.ClientName.ValueString(),
+		ClientUri:                    r.ClientUri.ValueString(),
+		RedirectUris:                 tfutils.SetToStringSlice(r.RedirectUris),
+		AccessTokenSignedResponseAlg: r.AccessTokenSignedResponseAlg.ValueString(),
+		IdTokenSignedResponseAlg:     r.IdTokenSignedResponseAlg.ValueString(),
+		DefaultMaxAge:                r.DefaultMaxAge.ValueInt64Pointer(),
+		RequireAuthTime:              r.RequireAuthTime.ValueBoolPointer(),
+	}
+
+	if !r.Contacts.IsNull() {
+		client.Contacts = tfutils.SetToStringSlice(r.Contacts)
+	}
+
+	if !r.PostLogoutRedirectUris.IsNull() {
+		client.PostLogoutRedirectUris = tfutils.SetToStringSlice(r.PostLogoutRedirectUris)
+	}
+
+	if !r.GrantTypes.IsNull() {
+		client.GrantTypes = tfutils.SetToStringSlice(r.GrantTypes)
+	}
+
+	return client
+}
@@ -0,0 +1,90 @@
+package oidc_client_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/acctest"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/oidc_client"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/tfutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccEphemeralClientResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralClientResourceConfig("https://cli.example.com/client.json", "CLI"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"rauthy_ephemeral_client.cli",
+						tfjsonpath.New("metadata_document"),
+						knownvalue.StringExact(`{"client_id":"https://cli.example.com/client.json","client_name":"CLI","redirect_uris":["http://localhost/callback"]}`),
+					),
+				},
+			},
+			{
+				Config:      testAccEphemeralClientResourceConfig("http://cli.example.com/client.json", "CLI"),
+				ExpectError: regexp.MustCompile(`client\s+ID\s+must\s+be\s+an\s+absolute\s+https\s+URL,\s+got:\s+http://cli\.example\.com/client\.json`),
+			},
+		},
+	})
+}
+
+func TestEphemeralClientResource_ValidateConfigAttributePaths(t *testing.T) {
+	ctx := context.Background()
+	r := oidc_client.NewEphemeralClientResource()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	diags := state.Set(ctx, &oidc_client.EphemeralClientResourceModel{
+		Id:                     types.StringValue("http://cli.example.com/client.json"),
+		ClientName:             types.StringValue("CLI"),
+		Contacts:               types.SetNull(types.StringType),
+		RedirectUris:           tfutils.StringSliceToSet([]string{"/callback"}),
+		PostLogoutRedirectUris: types.SetNull(types.StringType),
+		GrantTypes:             types.SetNull(types.StringType),
+	})
+	assert.False(t, diags.HasError(), diags)
+
+	resp := &fwresource.ValidateConfigResponse{}
+	r.(fwresource.ResourceWithValidateConfig).ValidateConfig(ctx, fwresource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw},
+	}, resp)
+
+	errs := resp.Diagnostics.Errors()
+	assert.Len(t, errs, 2)
+
+	paths := map[string]string{}
+	for _, d := range errs {
+		paths[d.(diag.DiagnosticWithPath).Path().String()] = d.Detail()
+	}
+
+	assert.Equal(t, "client ID must be an absolute https URL, got: http://cli.example.com/client.json", paths["id"])
+	assert.Contains(t, paths, "redirect_uris")
+}
+
+func testAccEphemeralClientResourceConfig(id, name string) string {
+	return fmt.Sprintf(`
+resource "rauthy_ephemeral_client" "cli" {
+	id = %[1]q
+	client_name = %[2]q
+	redirect_uris = ["http://localhost/callback"]
+}
+`, id, name)
+}
@@ -0,0 +1,173 @@
+package oidc_client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/utils"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/tfutils"
+)
+
+var _ datasource.DataSource = &OidcClientConfigDataSource{}
+
+func NewOidcClientConfigDataSource() datasource.DataSource {
+	return &OidcClientConfigDataSource{}
+}
+
+type OidcClientConfigDataSource struct {
+	client *rauthy.Client
+}
+
+func (d *OidcClientConfigDataSource) SetClient(c *rauthy.Client) {
+	d.client = c
+}
+
+type OidcClientConfigDataSourceModel struct {
+	ClientId              types.String `tfsdk:"client_id"`
+	Confidential          types.Bool   `tfsdk:"confidential"`
+	Issuer                types.String `tfsdk:"issuer"`
+	AuthorizationEndpoint types.String `tfsdk:"authorization_endpoint"`
+	TokenEndpoint         types.String `tfsdk:"token_endpoint"`
+	UserinfoEndpoint      types.String `tfsdk:"userinfo_endpoint"`
+	IntrospectionEndpoint types.String `tfsdk:"introspection_endpoint"`
+	EndSessionEndpoint    types.String `tfsdk:"end_session_endpoint"`
+	JwksUri               types.String `tfsdk:"jwks_uri"`
+	RedirectUris          types.List   `tfsdk:"redirect_uris"`
+	Scopes                types.List   `tfsdk:"scopes"`
+	DefaultScopes         types.List   `tfsdk:"default_scopes"`
+	PkceRequired          types.Bool   `tfsdk:"pkce_required"`
+	Challenges            types.List   `tfsdk:"challenges"`
+	AccessTokenAlg        types.String `tfsdk:"access_token_alg"`
+	IdTokenAlg            types.String `tfsdk:"id_token_alg"`
+}
+
+func (d *OidcClientConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_config"
+}
+
+func (d *OidcClientConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "OIDC settings of a client combined with the discovery document of the Rauthy instance, " +
+			"everything an application needs to be configured",
+
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "Client ID",
+				Required:            true,
+			},
+			"confidential": schema.BoolAttribute{
+				MarkdownDescription: "Whether the client must authenticate with a secret",
+				Computed:            true,
+			},
+			"issuer": schema.StringAttribute{
+				MarkdownDescription: "Issuer",
+				Computed:            true,
+			},
+			"authorization_endpoint": schema.StringAttribute{
+				MarkdownDescription: "Authorization endpoint",
+				Computed:            true,
+			},
+			"token_endpoint": schema.StringAttribute{
+				MarkdownDescription: "Token endpoint",
+				Computed:            true,
+			},
+			"userinfo_endpoint": schema.StringAttribute{
+				MarkdownDescription: "Userinfo endpoint",
+				Computed:            true,
+			},
+			"introspection_endpoint": schema.StringAttribute{
+				MarkdownDescription: "Token introspection endpoint",
+				Computed:            true,
+			},
+			"end_session_endpoint": schema.StringAttribute{
+				MarkdownDescription: "End session (logout) endpoint",
+				Computed:            true,
+			},
+			"jwks_uri": schema.StringAttribute{
+				MarkdownDescription: "JWKS URI",
+				Computed:            true,
+			},
+			"redirect_uris": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Redirect URIs",
+				Computed:            true,
+			},
+			"scopes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Scopes the client is allowed to request",
+				Computed:            true,
+			},
+			"default_scopes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Scopes granted without being requested",
+				Computed:            true,
+			},
+			"pkce_required": schema.BoolAttribute{
+				MarkdownDescription: "Whether authorization requests must use PKCE",
+				Computed:            true,
+			},
+			"challenges": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Accepted PKCE challenge methods",
+				Computed:            true,
+			},
+			"access_token_alg": schema.StringAttribute{
+				MarkdownDescription: "Access token algorithm",
+				Computed:            true,
+			},
+			"id_token_alg": schema.StringAttribute{
+				MarkdownDescription: "ID token algorithm",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *OidcClientConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	utils.ConfigureDataSource(ctx, req, resp, d)
+}
+
+func (d *OidcClientConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OidcClientConfigDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oidcClient, err := d.client.GetOidcClient(ctx, data.ClientId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OIDC client, got error: %s", err))
+		return
+	}
+
+	config, err := d.client.GetOpenIdConfiguration(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OpenID configuration, got error: %s", err))
+		return
+	}
+
+	data.Confidential = types.BoolValue(oidcClient.Confidential)
+	data.RedirectUris = tfutils.StringSliceToList(oidcClient.RedirectUris)
+	data.Scopes = tfutils.StringSliceToList(oidcClient.Scopes)
+	data.DefaultScopes = tfutils.StringSliceToList(oidcClient.DefaultScopes)
+	data.PkceRequired = types.BoolValue(len(oidcClient.Challenges) > 0)
+	data.Challenges = tfutils.StringSliceToList(oidcClient.Challenges)
+	data.AccessTokenAlg = types.StringValue(oidcClient.AccessTokenAlg)
+	data.IdTokenAlg = types.StringValue(oidcClient.IdTokenAlg)
+
+	data.Issuer = types.StringValue(config.Issuer)
+	data.AuthorizationEndpoint = types.StringValue(config.AuthorizationEndpoint)
+	data.TokenEndpoint = types.StringValue(config.TokenEndpoint)
+	data.UserinfoEndpoint = types.StringValue(config.UserinfoEndpoint)
+	data.IntrospectionEndpoint = utils.StringPtrToFramework(utils.EmptyToNil(config.IntrospectionEndpoint))
+	data.EndSessionEndpoint = utils.StringPtrToFramework(utils.EmptyToNil(config.EndSessionEndpoint))
+	data.JwksUri = types.StringValue(config.JwksUri)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,43 @@
+package oidc_client_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/acctest"
+)
+
+func TestAccOidcClientConfigDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOidcClientConfigDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.rauthy_client_config.test", "client_id", "test-client-config"),
+					resource.TestCheckResourceAttr("data.rauthy_client_config.test", "pkce_required", "true"),
+					resource.TestCheckResourceAttr("data.rauthy_client_config.test", "scopes.#", "2"),
+					resource.TestMatchResourceAttr("data.rauthy_client_config.test", "issuer", regexp.MustCompile(`/auth/v1/?$`)),
+					resource.TestMatchResourceAttr("data.rauthy_client_config.test", "token_endpoint", regexp.MustCompile(`/oidc/token$`)),
+					resource.TestCheckResourceAttrSet("data.rauthy_client_config.test", "jwks_uri"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOidcClientConfigDataSourceConfig = `
+resource "rauthy_client" "test" {
+	id = "test-client-config"
+	name = "test-client-config"
+	redirect_uris = ["http://localhost:8080/callback"]
+	scopes = ["openid", "email"]
+	challenges = ["S256"]
+}
+
+data "rauthy_client_config" "test" {
+	client_id = rauthy_client.test.id
+}
+`
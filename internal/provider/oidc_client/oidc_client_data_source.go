@@ -3,15 +3,19 @@ package oidc_client
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
 )
 
 var _ datasource.DataSource = &OidcClientDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &OidcClientDataSource{}
 
 func NewOidcClientDataSource() datasource.DataSource {
 	return &OidcClientDataSource{}
@@ -24,6 +28,7 @@ type OidcClientDataSource struct {
 type OidcClientDataSourceModel struct {
 	Id                     types.String `tfsdk:"id"`
 	Name                   types.String `tfsdk:"name"`
+	RedirectUri            types.String `tfsdk:"redirect_uri"`
 	Enabled                types.Bool   `tfsdk:"enabled"`
 	Confidential           types.Bool   `tfsdk:"confidential"`
 	RedirectUris           types.List   `tfsdk:"redirect_uris"`
@@ -51,13 +56,19 @@ func (d *OidcClientDataSource) Schema(ctx context.Context, req datasource.Schema
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the client",
-				Required:            true,
+				MarkdownDescription: "The ID of the client. Exactly one of `id`, `name` or `redirect_uri` must be set",
+				Optional:            true,
+				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the client",
+				MarkdownDescription: "The name of the client. Exactly one of `id`, `name` or `redirect_uri` must be set",
+				Optional:            true,
 				Computed:            true,
 			},
+			"redirect_uri": schema.StringAttribute{
+				MarkdownDescription: "One of the redirect URIs of the client. Exactly one of `id`, `name` or `redirect_uri` must be set",
+				Optional:            true,
+			},
 			"enabled": schema.BoolAttribute{
 				MarkdownDescription: "Whether the client is enabled",
 				Computed:            true,
@@ -148,6 +159,75 @@ func (d *OidcClientDataSource) Configure(ctx context.Context, req datasource.Con
 	d.client = client
 }
 
+func (d *OidcClientDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data OidcClientDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configured := 0
+	for _, v := range []types.String{data.Id, data.Name, data.RedirectUri} {
+		if !v.IsNull() {
+			configured++
+		}
+	}
+
+	if configured != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Attribute Combination",
+			"Exactly one of `id`, `name` or `redirect_uri` must be set",
+		)
+	}
+}
+
+// findOidcClient resolves the client by whichever lookup attribute is set.
+func (d *OidcClientDataSource) findOidcClient(ctx context.Context, data *OidcClientDataSourceModel) (*rauthy.OidcClient, error) {
+	if !data.Id.IsNull() {
+		oidcClient, err := d.client.GetOidcClient(ctx, data.Id.ValueString())
+		return &oidcClient, err
+	}
+
+	oidcClients, err := d.client.GetOidcClients(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lookup := fmt.Sprintf("redirect URI %q", data.RedirectUri.ValueString())
+	if !data.Name.IsNull() {
+		lookup = fmt.Sprintf("name %q", data.Name.ValueString())
+	}
+
+	var matches []rauthy.OidcClient
+
+	for _, c := range oidcClients {
+		if !data.Name.IsNull() && c.Name == data.Name.ValueString() {
+			matches = append(matches, c)
+		}
+
+		if !data.RedirectUri.IsNull() && slices.Contains(c.RedirectUris, data.RedirectUri.ValueString()) {
+			matches = append(matches, c)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no client found with %s", lookup)
+	case 1:
+		return &matches[0], nil
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, c := range matches {
+			ids = append(ids, c.Id)
+		}
+
+		return nil, fmt.Errorf("%d clients found with %s: %s, use `id` to select one", len(matches), lookup, strings.Join(ids, ", "))
+	}
+}
+
 func (d *OidcClientDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data OidcClientDataSourceModel
 
@@ -157,12 +237,13 @@ func (d *OidcClientDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	oidcClient, err := d.client.GetOidcClient(ctx, data.Id.ValueString())
+	oidcClient, err := d.findOidcClient(ctx, &data)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OIDC client, got error: %s", err))
 		return
 	}
 
+	data.Id = types.StringValue(oidcClient.Id)
 	data.Name = types.StringValue(oidcClient.Name)
 	data.Enabled = types.BoolValue(oidcClient.Enabled)
 	data.Confidential = types.BoolValue(oidcClient.Confidential)
@@ -2,6 +2,7 @@ package oidc_client_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -18,8 +19,14 @@ func TestAccOidcClientDataSource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrPair("data.rauthy_client.test", "name", "rauthy_client.test", "name"),
 					resource.TestCheckResourceAttrSet("data.rauthy_client.test", "id"),
+					resource.TestCheckResourceAttrPair("data.rauthy_client.by_name", "id", "rauthy_client.test", "id"),
+					resource.TestCheckResourceAttrPair("data.rauthy_client.by_redirect_uri", "id", "rauthy_client.test", "id"),
 				),
 			},
+			{
+				Config:      testAccOidcClientDataSourceConfig("test-client-ds") + testAccOidcClientDataSourceNotFoundConfig,
+				ExpectError: regexp.MustCompile("no client found with name"),
+			},
 		},
 	})
 }
@@ -29,11 +36,26 @@ func testAccOidcClientDataSourceConfig(name string) string {
 resource "rauthy_client" "test" {
 	id = %[1]q
 	name = %[1]q
-	redirect_uris = ["http://localhost:8080"]
+	redirect_uris = ["http://localhost:8080", "http://localhost:8080/%[1]s"]
 }
 
 data "rauthy_client" "test" {
 	id = rauthy_client.test.id
 }
+
+data "rauthy_client" "by_name" {
+	name = rauthy_client.test.name
+}
+
+data "rauthy_client" "by_redirect_uri" {
+	redirect_uri = "http://localhost:8080/%[1]s"
+	depends_on = [rauthy_client.test]
+}
 `, name)
 }
+
+const testAccOidcClientDataSourceNotFoundConfig = `
+data "rauthy_client" "missing" {
+	name = "does-not-exist"
+}
+`
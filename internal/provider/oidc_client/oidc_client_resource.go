@@ -3,6 +3,8 @@ package oidc_client
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -10,8 +12,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
 	"github.com/moonlight8978/terraform-provider-rauthy/pkg/tfutils"
@@ -19,6 +24,9 @@ import (
 
 var _ resource.Resource = &OidcClientResource{}
 var _ resource.ResourceWithImportState = &OidcClientResource{}
+var _ resource.ResourceWithUpgradeState = &OidcClientResource{}
+var _ resource.ResourceWithModifyPlan = &OidcClientResource{}
+var _ resource.ResourceWithValidateConfig = &OidcClientResource{}
 
 func NewOidcClientResource() resource.Resource {
 	return &OidcClientResource{}
@@ -33,16 +41,16 @@ type OidcClientResourceModel struct {
 	Name                   types.String `tfsdk:"name"`
 	Enabled                types.Bool   `tfsdk:"enabled"`
 	Confidential           types.Bool   `tfsdk:"confidential"`
-	RedirectUris           types.List   `tfsdk:"redirect_uris"`
-	PostLogoutRedirectUris types.List   `tfsdk:"post_logout_redirect_uris"`
-	FlowsEnabled           types.List   `tfsdk:"flows_enabled"`
+	RedirectUris           types.Set    `tfsdk:"redirect_uris"`
+	PostLogoutRedirectUris types.Set    `tfsdk:"post_logout_redirect_uris"`
+	FlowsEnabled           types.Set    `tfsdk:"flows_enabled"`
 	AccessTokenAlg         types.String `tfsdk:"access_token_alg"`
 	IdTokenAlg             types.String `tfsdk:"id_token_alg"`
 	AuthCodeLifetime       types.Int64  `tfsdk:"auth_code_lifetime"`
 	AccessTokenLifetime    types.Int64  `tfsdk:"access_token_lifetime"`
-	Scopes                 types.List   `tfsdk:"scopes"`
-	DefaultScopes          types.List   `tfsdk:"default_scopes"`
-	Challenges             types.List   `tfsdk:"challenges"`
+	Scopes                 types.Set    `tfsdk:"scopes"`
+	DefaultScopes          types.Set    `tfsdk:"default_scopes"`
+	Challenges             types.Set    `tfsdk:"challenges"`
 	ForceMfa               types.Bool   `tfsdk:"force_mfa"`
 	ClientUri              types.String `tfsdk:"client_uri"`
 	Contacts               types.List   `tfsdk:"contacts"`
@@ -55,11 +63,15 @@ func (r *OidcClientResource) Metadata(ctx context.Context, req resource.Metadata
 func (r *OidcClientResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Client resource",
+		Version:             2,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "Client ID",
+				MarkdownDescription: "Client ID. Changing this forces a new client to be created",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Client name",
@@ -77,26 +89,26 @@ func (r *OidcClientResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
-			"redirect_uris": schema.ListAttribute{
+			"redirect_uris": schema.SetAttribute{
 				MarkdownDescription: "Client redirect URIs",
 				ElementType:         types.StringType,
 				Computed:            true,
 				Optional:            true,
-				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
 			},
-			"post_logout_redirect_uris": schema.ListAttribute{
+			"post_logout_redirect_uris": schema.SetAttribute{
 				MarkdownDescription: "Client post logout redirect URIs",
 				ElementType:         types.StringType,
 				Computed:            true,
 				Optional:            true,
-				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
 			},
-			"flows_enabled": schema.ListAttribute{
+			"flows_enabled": schema.SetAttribute{
 				MarkdownDescription: "Client flows enabled",
 				ElementType:         types.StringType,
 				Computed:            true,
 				Optional:            true,
-				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("authorization_code")})),
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("authorization_code")})),
 			},
 			"access_token_alg": schema.StringAttribute{
 				MarkdownDescription: "Client access token algorithm",
@@ -122,24 +134,30 @@ func (r *OidcClientResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Optional:            true,
 				Default:             int64default.StaticInt64(1800),
 			},
-			"scopes": schema.ListAttribute{
+			"scopes": schema.SetAttribute{
 				MarkdownDescription: "Client scopes",
 				ElementType:         types.StringType,
 				Computed:            true,
 				Optional:            true,
-				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("openid")})),
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("openid")})),
 			},
-			"default_scopes": schema.ListAttribute{
-				MarkdownDescription: "Client default scopes",
-				ElementType:         types.StringType,
-				Computed:            true,
+			"default_scopes": schema.SetAttribute{
+				MarkdownDescription: "Scopes granted without being requested, must be a subset of `scopes`. " +
+					"Scopes are referenced by name, so custom scopes must exist in Rauthy before they are listed here. " +
+					"Defaults to what Rauthy assigns when not set",
+				ElementType: types.StringType,
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"challenges": schema.ListAttribute{
+			"challenges": schema.SetAttribute{
 				MarkdownDescription: "Client challenges",
 				ElementType:         types.StringType,
 				Computed:            true,
 				Optional:            true,
-				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("S256")})),
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("S256")})),
 			},
 			"force_mfa": schema.BoolAttribute{
 				MarkdownDescription: "Client force MFA",
@@ -179,6 +197,108 @@ func (r *OidcClientResource) Configure(ctx context.Context, req resource.Configu
 	r.client = client
 }
 
+func (r *OidcClientResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config OidcClientResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() || config.DefaultScopes.IsNull() || config.DefaultScopes.IsUnknown() || config.Scopes.IsUnknown() {
+		return
+	}
+
+	// Unknown elements are only resolved at apply, the check runs again then
+	if hasUnknownElements(config.Scopes) || hasUnknownElements(config.DefaultScopes) {
+		return
+	}
+
+	// Matches the schema default when scopes is not set
+	scopes := []string{"openid"}
+	if !config.Scopes.IsNull() {
+		scopes = tfutils.SetToStringSlice(config.Scopes)
+	}
+
+	for _, scope := range tfutils.SetToStringSlice(config.DefaultScopes) {
+		if !slices.Contains(scopes, scope) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("default_scopes"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Default scope %q must also be listed in `scopes`", scope),
+			)
+		}
+	}
+}
+
+func (r *OidcClientResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing is invalidated when the client is created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan OidcClientResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.planDefaultScopes(ctx, req, &plan, resp)
+
+	if plan.Id.IsUnknown() || plan.Id.Equal(state.Id) {
+		return
+	}
+
+	detail := fmt.Sprintf(
+		"Client %s will be deleted and recreated as %s. Authorization requests using the redirect URIs [%s] with the old client ID will fail.",
+		state.Id.ValueString(),
+		plan.Id.ValueString(),
+		strings.Join(tfutils.SetToStringSlice(state.RedirectUris), ", "),
+	)
+
+	if state.Confidential.ValueBool() {
+		detail += " The client secret and any rauthy_client_secret issued for it will be invalidated."
+	}
+
+	resp.Diagnostics.AddWarning("Client Will Be Replaced", detail)
+}
+
+// planDefaultScopes drops the default scopes kept from state that are no
+// longer listed in `scopes`. Without it, removing a scope while leaving
+// `default_scopes` unset plans a value Rauthy rejects on apply.
+func (r *OidcClientResource) planDefaultScopes(ctx context.Context, req resource.ModifyPlanRequest, plan *OidcClientResourceModel, resp *resource.ModifyPlanResponse) {
+	var configured types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("default_scopes"), &configured)...)
+
+	if resp.Diagnostics.HasError() || !configured.IsNull() {
+		return
+	}
+
+	if plan.DefaultScopes.IsUnknown() || plan.DefaultScopes.IsNull() {
+		return
+	}
+
+	if plan.Scopes.IsUnknown() || hasUnknownElements(plan.Scopes) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("default_scopes"), types.SetUnknown(types.StringType))...)
+		return
+	}
+
+	scopes := tfutils.SetToStringSlice(plan.Scopes)
+	defaultScopes := slices.DeleteFunc(tfutils.SetToStringSlice(plan.DefaultScopes), func(scope string) bool {
+		return !slices.Contains(scopes, scope)
+	})
+
+	plan.DefaultScopes = tfutils.StringSliceToSet(defaultScopes)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("default_scopes"), plan.DefaultScopes)...)
+}
+
+func hasUnknownElements(s types.Set) bool {
+	return slices.ContainsFunc(s.Elements(), func(v attr.Value) bool {
+		return v.IsUnknown()
+	})
+}
+
 func (r *OidcClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data OidcClientResourceModel
 
@@ -200,6 +320,7 @@ func (r *OidcClientResource) Create(ctx context.Context, req resource.CreateRequ
 	client, err := r.client.UpdateOidcClient(ctx, newClient.Id, &apiModel)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update client, got error: %s", err))
+		r.rollbackCreate(ctx, &data, &newClient, resp)
 		return
 	}
 
@@ -208,6 +329,25 @@ func (r *OidcClientResource) Create(ctx context.Context, req resource.CreateRequ
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// rollbackCreate removes a client whose follow-up update failed, so that a
+// retry can create it again. If the client cannot be removed, it is saved to
+// state instead and Terraform marks it as tainted, replacing it on the next apply.
+func (r *OidcClientResource) rollbackCreate(ctx context.Context, data *OidcClientResourceModel, created *rauthy.OidcClient, resp *resource.CreateResponse) {
+	err := r.client.DeleteOidcClient(ctx, created.Id)
+	if err == nil {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Client Rollback Failed",
+		fmt.Sprintf("Client %s was created but could not be configured or removed, it will be replaced on the next apply. Got error: %s", created.Id, err),
+	)
+
+	data.FromApiResource(created)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
 func (r *OidcClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data OidcClientResourceModel
 
@@ -277,20 +417,27 @@ func (r *OidcClientResource) ImportState(ctx context.Context, req resource.Impor
 }
 
 func (r *OidcClientResourceModel) ToApi() rauthy.OidcClient {
+	// Unknown until Rauthy assigns them, so they are left out of the request
+	var defaultScopes []string
+	if !r.DefaultScopes.IsUnknown() {
+		defaultScopes = tfutils.SetToStringSlice(r.DefaultScopes)
+	}
+
 	return rauthy.OidcClient{
 		Id:                  r.Id.ValueString(),
 		Name:                r.Name.ValueString(),
 		Enabled:             r.Enabled.ValueBool(),
 		Confidential:        r.Confidential.ValueBool(),
-		RedirectUris:        tfutils.ListToStringSlice(r.RedirectUris),
-		FlowsEnabled:        tfutils.ListToStringSlice(r.FlowsEnabled),
+		RedirectUris:        tfutils.SetToStringSlice(r.RedirectUris),
+		PostLogoutUri:       tfutils.SetToStringSlice(r.PostLogoutRedirectUris),
+		FlowsEnabled:        tfutils.SetToStringSlice(r.FlowsEnabled),
 		AccessTokenAlg:      r.AccessTokenAlg.ValueString(),
 		IdTokenAlg:          r.IdTokenAlg.ValueString(),
 		AuthCodeLifetime:    r.AuthCodeLifetime.ValueInt64(),
 		AccessTokenLifetime: r.AccessTokenLifetime.ValueInt64(),
-		Scopes:              tfutils.ListToStringSlice(r.Scopes),
-		DefaultScopes:       tfutils.ListToStringSlice(r.DefaultScopes),
-		Challenges:          tfutils.ListToStringSlice(r.Challenges),
+		Scopes:              tfutils.SetToStringSlice(r.Scopes),
+		DefaultScopes:       defaultScopes,
+		Challenges:          tfutils.SetToStringSlice(r.Challenges),
 		ForceMfa:            r.ForceMfa.ValueBool(),
 	}
 }
@@ -299,17 +446,165 @@ func (r *OidcClientResourceModel) FromApiResource(client *rauthy.OidcClient) {
 	r.Name = types.StringValue(client.Name)
 	r.Enabled = types.BoolValue(client.Enabled)
 	r.Confidential = types.BoolValue(client.Confidential)
-	r.RedirectUris = tfutils.StringSliceToList(client.RedirectUris)
-	r.FlowsEnabled = tfutils.StringSliceToList(client.FlowsEnabled)
-	r.Scopes = tfutils.StringSliceToList(client.Scopes)
-	r.Challenges = tfutils.StringSliceToList(client.Challenges)
+	r.RedirectUris = tfutils.StringSliceToSet(client.RedirectUris)
+	r.PostLogoutRedirectUris = tfutils.StringSliceToSet(client.PostLogoutUri)
+	r.FlowsEnabled = tfutils.StringSliceToSet(client.FlowsEnabled)
+	r.Scopes = tfutils.StringSliceToSet(client.Scopes)
+	r.Challenges = tfutils.StringSliceToSet(client.Challenges)
 
 	r.AccessTokenAlg = types.StringValue(client.AccessTokenAlg)
 	r.AuthCodeLifetime = types.Int64Value(client.AuthCodeLifetime)
-	r.DefaultScopes = tfutils.StringSliceToList(client.DefaultScopes)
+	r.DefaultScopes = tfutils.StringSliceToSet(client.DefaultScopes)
 	r.ForceMfa = types.BoolValue(client.ForceMfa)
 	r.IdTokenAlg = types.StringValue(client.IdTokenAlg)
 	r.AccessTokenLifetime = types.Int64Value(client.AccessTokenLifetime)
 	r.ClientUri = types.StringValue(client.ClientUri)
 	r.Contacts = tfutils.StringSliceToList(client.Contacts)
 }
+
+// oidcClientResourceModelV0 is the state model for schema version 0, where
+// the URI, flow, scope and challenge attributes were ordered lists.
+type oidcClientResourceModelV0 struct {
+	Id                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	Confidential           types.Bool   `tfsdk:"confidential"`
+	RedirectUris           types.List   `tfsdk:"redirect_uris"`
+	PostLogoutRedirectUris types.List   `tfsdk:"post_logout_redirect_uris"`
+	FlowsEnabled           types.List   `tfsdk:"flows_enabled"`
+	AccessTokenAlg         types.String `tfsdk:"access_token_alg"`
+	IdTokenAlg             types.String `tfsdk:"id_token_alg"`
+	AuthCodeLifetime       types.Int64  `tfsdk:"auth_code_lifetime"`
+	AccessTokenLifetime    types.Int64  `tfsdk:"access_token_lifetime"`
+	Scopes                 types.List   `tfsdk:"scopes"`
+	DefaultScopes          types.List   `tfsdk:"default_scopes"`
+	Challenges             types.List   `tfsdk:"challenges"`
+	ForceMfa               types.Bool   `tfsdk:"force_mfa"`
+	ClientUri              types.String `tfsdk:"client_uri"`
+	Contacts               types.List   `tfsdk:"contacts"`
+}
+
+// oidcClientResourceModelV1 is the state model for schema version 1, where
+// default_scopes was a computed list.
+type oidcClientResourceModelV1 struct {
+	Id                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	Confidential           types.Bool   `tfsdk:"confidential"`
+	RedirectUris           types.Set    `tfsdk:"redirect_uris"`
+	PostLogoutRedirectUris types.Set    `tfsdk:"post_logout_redirect_uris"`
+	FlowsEnabled           types.Set    `tfsdk:"flows_enabled"`
+	AccessTokenAlg         types.String `tfsdk:"access_token_alg"`
+	IdTokenAlg             types.String `tfsdk:"id_token_alg"`
+	AuthCodeLifetime       types.Int64  `tfsdk:"auth_code_lifetime"`
+	AccessTokenLifetime    types.Int64  `tfsdk:"access_token_lifetime"`
+	Scopes                 types.Set    `tfsdk:"scopes"`
+	DefaultScopes          types.List   `tfsdk:"default_scopes"`
+	Challenges             types.Set    `tfsdk:"challenges"`
+	ForceMfa               types.Bool   `tfsdk:"force_mfa"`
+	ClientUri              types.String `tfsdk:"client_uri"`
+	Contacts               types.List   `tfsdk:"contacts"`
+}
+
+// oidcClientPriorSchema builds the schema of a prior version, collection is
+// used for the attributes which moved from lists to sets.
+func oidcClientPriorSchema(collection func() schema.Attribute) *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                        schema.StringAttribute{Required: true},
+			"name":                      schema.StringAttribute{Required: true},
+			"enabled":                   schema.BoolAttribute{Optional: true, Computed: true},
+			"confidential":              schema.BoolAttribute{Optional: true, Computed: true},
+			"redirect_uris":             collection(),
+			"post_logout_redirect_uris": collection(),
+			"flows_enabled":             collection(),
+			"access_token_alg":          schema.StringAttribute{Optional: true, Computed: true},
+			"id_token_alg":              schema.StringAttribute{Optional: true, Computed: true},
+			"auth_code_lifetime":        schema.Int64Attribute{Optional: true, Computed: true},
+			"access_token_lifetime":     schema.Int64Attribute{Optional: true, Computed: true},
+			"scopes":                    collection(),
+			"default_scopes":            schema.ListAttribute{ElementType: types.StringType, Computed: true},
+			"challenges":                collection(),
+			"force_mfa":                 schema.BoolAttribute{Optional: true, Computed: true},
+			"client_uri":                schema.StringAttribute{Computed: true},
+			"contacts":                  schema.ListAttribute{ElementType: types.StringType, Computed: true},
+		},
+	}
+}
+
+func (r *OidcClientResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: oidcClientPriorSchema(func() schema.Attribute {
+				return schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true}
+			}),
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior oidcClientResourceModelV0
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgraded := OidcClientResourceModel{
+					Id:                     prior.Id,
+					Name:                   prior.Name,
+					Enabled:                prior.Enabled,
+					Confidential:           prior.Confidential,
+					RedirectUris:           tfutils.StringSliceToSet(tfutils.ListToStringSlice(prior.RedirectUris)),
+					PostLogoutRedirectUris: tfutils.StringSliceToSet(tfutils.ListToStringSlice(prior.PostLogoutRedirectUris)),
+					FlowsEnabled:           tfutils.StringSliceToSet(tfutils.ListToStringSlice(prior.FlowsEnabled)),
+					AccessTokenAlg:         prior.AccessTokenAlg,
+					IdTokenAlg:             prior.IdTokenAlg,
+					AuthCodeLifetime:       prior.AuthCodeLifetime,
+					AccessTokenLifetime:    prior.AccessTokenLifetime,
+					Scopes:                 tfutils.StringSliceToSet(tfutils.ListToStringSlice(prior.Scopes)),
+					DefaultScopes:          tfutils.StringSliceToSet(tfutils.ListToStringSlice(prior.DefaultScopes)),
+					Challenges:             tfutils.StringSliceToSet(tfutils.ListToStringSlice(prior.Challenges)),
+					ForceMfa:               prior.ForceMfa,
+					ClientUri:              prior.ClientUri,
+					Contacts:               prior.Contacts,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &upgraded)...)
+			},
+		},
+		1: {
+			PriorSchema: oidcClientPriorSchema(func() schema.Attribute {
+				return schema.SetAttribute{ElementType: types.StringType, Optional: true, Computed: true}
+			}),
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior oidcClientResourceModelV1
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgraded := OidcClientResourceModel{
+					Id:                     prior.Id,
+					Name:                   prior.Name,
+					Enabled:                prior.Enabled,
+					Confidential:           prior.Confidential,
+					RedirectUris:           prior.RedirectUris,
+					PostLogoutRedirectUris: prior.PostLogoutRedirectUris,
+					FlowsEnabled:           prior.FlowsEnabled,
+					AccessTokenAlg:         prior.AccessTokenAlg,
+					IdTokenAlg:             prior.IdTokenAlg,
+					AuthCodeLifetime:       prior.AuthCodeLifetime,
+					AccessTokenLifetime:    prior.AccessTokenLifetime,
+					Scopes:                 prior.Scopes,
+					DefaultScopes:          tfutils.StringSliceToSet(tfutils.ListToStringSlice(prior.DefaultScopes)),
+					Challenges:             prior.Challenges,
+					ForceMfa:               prior.ForceMfa,
+					ClientUri:              prior.ClientUri,
+					Contacts:               prior.Contacts,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &upgraded)...)
+			},
+		},
+	}
+}
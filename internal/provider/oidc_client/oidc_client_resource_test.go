@@ -1,14 +1,29 @@
 package oidc_client_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/acctest"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/oidc_client"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/tfutils"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccClientResource(t *testing.T) {
@@ -37,7 +52,12 @@ func TestAccClientResource(t *testing.T) {
 					statecheck.ExpectKnownValue(
 						"rauthy_client.google",
 						tfjsonpath.New("redirect_uris"),
-						knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("http://localhost/callback")}),
+						knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("http://localhost/callback")}),
+					),
+					statecheck.ExpectKnownValue(
+						"rauthy_client.google",
+						tfjsonpath.New("default_scopes"),
+						knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("openid")}),
 					),
 				},
 			},
@@ -56,10 +76,231 @@ func TestAccClientResource(t *testing.T) {
 					),
 				},
 			},
+			{
+				Config: testAccClientResourceConfig("google-2", "Google 2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("rauthy_client.google", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"rauthy_client.google",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("google-2"),
+					),
+				},
+			},
+		},
+	})
+}
+
+// The follow-up update fails against a stub server, the created client must
+// be deleted again so that the next apply can create it.
+func TestClientResource_RollbackOnUpdateFailure(t *testing.T) {
+	var deleted []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			fmt.Fprintln(w, `{"id": "rollback", "name": "rollback", "confidential": false, "redirect_uris": ["http://localhost/callback"]}`)
+		case http.MethodPut:
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintln(w, `{"error": "BadRequest", "message": "invalid scope"}`)
+		case http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	r := oidc_client.NewOidcClientResource()
+
+	configureResp := &fwresource.ConfigureResponse{}
+	r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{
+		ProviderData: rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret")),
+	}, configureResp)
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	diags := plan.Set(ctx, &oidc_client.OidcClientResourceModel{
+		Id:                     types.StringValue("rollback"),
+		Name:                   types.StringValue("rollback"),
+		Enabled:                types.BoolValue(true),
+		Confidential:           types.BoolValue(false),
+		RedirectUris:           tfutils.StringSliceToSet([]string{"http://localhost/callback"}),
+		PostLogoutRedirectUris: tfutils.StringSliceToSet(nil),
+		FlowsEnabled:           tfutils.StringSliceToSet([]string{"authorization_code"}),
+		AccessTokenAlg:         types.StringValue("EdDSA"),
+		IdTokenAlg:             types.StringValue("EdDSA"),
+		AuthCodeLifetime:       types.Int64Value(60),
+		AccessTokenLifetime:    types.Int64Value(1800),
+		Scopes:                 tfutils.StringSliceToSet([]string{"openid"}),
+		DefaultScopes:          types.SetUnknown(types.StringType),
+		Challenges:             tfutils.StringSliceToSet([]string{"S256"}),
+		ForceMfa:               types.BoolValue(false),
+		ClientUri:              types.StringUnknown(),
+		Contacts:               types.ListUnknown(types.StringType),
+	})
+	assert.False(t, diags.HasError(), diags)
+
+	resp := &fwresource.CreateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, []string{"/auth/v1/clients/rollback"}, deleted)
+	assert.True(t, resp.State.Raw.IsNull())
+}
+
+func TestAccClientResource_DefaultScopesNotSubset(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "rauthy_client" "invalid" {
+	id = "invalid-default-scopes"
+	name = "invalid-default-scopes"
+	scopes = ["openid"]
+	default_scopes = ["openid", "profile"]
+}
+`,
+				ExpectError: regexp.MustCompile(`Default scope "profile" must also be listed in`),
+			},
 		},
 	})
 }
 
+func TestClientResource_ValidateConfigUnknownScope(t *testing.T) {
+	ctx := context.Background()
+	r := oidc_client.NewOidcClientResource()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	data := testClientResourceModel()
+	data.Scopes = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("openid"), types.StringUnknown()})
+	data.DefaultScopes = tfutils.StringSliceToSet([]string{"openid", "profile"})
+
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: testClientResourceValue(t, schemaResp.Schema, data)}
+
+	resp := &fwresource.ValidateConfigResponse{}
+	r.(fwresource.ResourceWithValidateConfig).ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: config}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+}
+
+func TestClientResource_ModifyPlanDropsRemovedDefaultScopes(t *testing.T) {
+	ctx := context.Background()
+	r := oidc_client.NewOidcClientResource()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	prior := testClientResourceModel()
+	prior.Scopes = tfutils.StringSliceToSet([]string{"openid", "email"})
+	prior.DefaultScopes = tfutils.StringSliceToSet([]string{"openid", "email"})
+
+	config := testClientResourceModel()
+	config.Scopes = tfutils.StringSliceToSet([]string{"openid"})
+	config.DefaultScopes = types.SetNull(types.StringType)
+
+	// UseStateForUnknown has already copied the prior default scopes
+	planned := testClientResourceModel()
+	planned.Scopes = tfutils.StringSliceToSet([]string{"openid"})
+	planned.DefaultScopes = prior.DefaultScopes
+
+	resp := &fwresource.ModifyPlanResponse{
+		Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: testClientResourceValue(t, schemaResp.Schema, planned)},
+	}
+	r.(fwresource.ResourceWithModifyPlan).ModifyPlan(ctx, fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: testClientResourceValue(t, schemaResp.Schema, config)},
+		State:  tfsdk.State{Schema: schemaResp.Schema, Raw: testClientResourceValue(t, schemaResp.Schema, prior)},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: testClientResourceValue(t, schemaResp.Schema, planned)},
+	}, resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var result oidc_client.OidcClientResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &result)...)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, []string{"openid"}, tfutils.SetToStringSlice(result.DefaultScopes))
+}
+
+func testClientResourceValue(t *testing.T, s schema.Schema, data *oidc_client.OidcClientResourceModel) tftypes.Value {
+	ctx := context.Background()
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+
+	diags := state.Set(ctx, data)
+	assert.False(t, diags.HasError(), diags)
+
+	return state.Raw
+}
+
+func testClientResourceModel() *oidc_client.OidcClientResourceModel {
+	return &oidc_client.OidcClientResourceModel{
+		Id:                     types.StringValue("scopes"),
+		Name:                   types.StringValue("scopes"),
+		Enabled:                types.BoolValue(true),
+		Confidential:           types.BoolValue(false),
+		RedirectUris:           tfutils.StringSliceToSet([]string{"http://localhost/callback"}),
+		PostLogoutRedirectUris: tfutils.StringSliceToSet(nil),
+		FlowsEnabled:           tfutils.StringSliceToSet([]string{"authorization_code"}),
+		AccessTokenAlg:         types.StringValue("EdDSA"),
+		IdTokenAlg:             types.StringValue("EdDSA"),
+		AuthCodeLifetime:       types.Int64Value(60),
+		AccessTokenLifetime:    types.Int64Value(1800),
+		Scopes:                 tfutils.StringSliceToSet([]string{"openid"}),
+		DefaultScopes:          tfutils.StringSliceToSet([]string{"openid"}),
+		Challenges:             tfutils.StringSliceToSet([]string{"S256"}),
+		ForceMfa:               types.BoolValue(false),
+		ClientUri:              types.StringNull(),
+		Contacts:               types.ListNull(types.StringType),
+	}
+}
+
+func TestAccClientResource_RemoveScopeWithoutDefaultScopes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClientResourceScopesConfig(`["openid", "email"]`),
+			},
+			{
+				Config: testAccClientResourceScopesConfig(`["openid"]`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"rauthy_client.scopes",
+						tfjsonpath.New("scopes"),
+						knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("openid")}),
+					),
+					statecheck.ExpectKnownValue(
+						"rauthy_client.scopes",
+						tfjsonpath.New("default_scopes"),
+						knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("openid")}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccClientResourceScopesConfig(scopes string) string {
+	return fmt.Sprintf(`
+resource "rauthy_client" "scopes" {
+	id = "remove-scope"
+	name = "remove-scope"
+	redirect_uris = ["http://localhost/callback"]
+	scopes = %s
+}
+`, scopes)
+}
+
 func testAccClientResourceConfig(id string, name string) string {
 	return fmt.Sprintf(`
 resource "rauthy_client" "google" {
@@ -73,7 +314,8 @@ resource "rauthy_client" "google" {
 	id_token_alg = "EdDSA"
 	auth_code_lifetime = 10
 	access_token_lifetime = 10
-	scopes = ["openid"]
+	scopes = ["openid", "email"]
+	default_scopes = ["openid"]
 	challenges = ["S256"]
 	force_mfa = false
 }
@@ -0,0 +1,133 @@
+package oidc_client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/utils"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+)
+
+var _ ephemeral.EphemeralResource = &OidcClientSecretEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &OidcClientSecretEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithValidateConfig = &OidcClientSecretEphemeralResource{}
+
+func NewOidcClientSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &OidcClientSecretEphemeralResource{}
+}
+
+type OidcClientSecretEphemeralResource struct {
+	client *rauthy.Client
+}
+
+func (r *OidcClientSecretEphemeralResource) SetClient(c *rauthy.Client) {
+	r.client = c
+}
+
+type OidcClientSecretEphemeralResourceModel struct {
+	ClientId          types.String `tfsdk:"client_id"`
+	Rotate            types.Bool   `tfsdk:"rotate"`
+	CacheCurrentHours types.Int64  `tfsdk:"cache_current_hours"`
+	Secret            types.String `tfsdk:"secret"`
+}
+
+func (r *OidcClientSecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_secret"
+}
+
+func (r *OidcClientSecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Client secret ephemeral resource. The secret is never written to state or plan, " +
+			"pass it to write-only attributes of other resources",
+
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "Client ID",
+				Required:            true,
+			},
+			"rotate": schema.BoolAttribute{
+				MarkdownDescription: "Issue a new secret instead of reading the current one. Terraform opens ephemeral " +
+					"resources during both plan and apply, so a new secret is issued each time, including on `terraform plan`. " +
+					"Requires `cache_current_hours` so that running applications keep working with the previous secret",
+				Optional: true,
+			},
+			"cache_current_hours": schema.Int64Attribute{
+				MarkdownDescription: "Hours the previous secret stays valid after rotation, must be at least 1 when `rotate` is set",
+				Optional:            true,
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "Secret",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *OidcClientSecretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	utils.ConfigureEphemeralResource(ctx, req, resp, r)
+}
+
+func (r *OidcClientSecretEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var model OidcClientSecretEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() || !model.Rotate.ValueBool() || model.CacheCurrentHours.IsUnknown() {
+		return
+	}
+
+	// A plan alone rotates the secret, without a grace period every
+	// application using the current secret would lose access at once
+	if model.CacheCurrentHours.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cache_current_hours"),
+			"Invalid Attribute Value",
+			"`cache_current_hours` must be at least 1 when `rotate` is set, the secret is rotated on every plan and apply",
+		)
+	}
+}
+
+func (r *OidcClientSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model OidcClientSecretEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var secret *rauthy.ClientSecret
+	var err error
+
+	if model.Rotate.ValueBool() {
+		secret, err = r.client.CreateClientSecret(ctx, model.ClientId.ValueString(), &rauthy.ClientSecretRequest{
+			CacheCurrentHours: int(model.CacheCurrentHours.ValueInt64()),
+		})
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rotate client secret, got error: %s", err))
+			return
+		}
+	} else {
+		secret, err = r.client.GetClientSecret(ctx, model.ClientId.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read client secret, got error: %s", err))
+			return
+		}
+	}
+
+	if !secret.Confidential {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Client %s is not confidential and has no secret", model.ClientId.ValueString()))
+		return
+	}
+
+	model.Secret = types.StringValue(secret.Secret)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
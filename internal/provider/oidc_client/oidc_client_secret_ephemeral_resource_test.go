@@ -0,0 +1,83 @@
+package oidc_client_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/acctest"
+)
+
+func TestAccClientSecretEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClientSecretEphemeralResourceConfig("ephemeralsecret"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("client_id"),
+						knownvalue.StringExact("ephemeralsecret"),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("secret"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccClientSecretEphemeralResource_RotateWithoutCache(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+ephemeral "rauthy_client_secret" "test" {
+	client_id = "rotate-without-cache"
+	rotate = true
+}
+`,
+				ExpectError: regexp.MustCompile(`must\s+be\s+at\s+least\s+1\s+when`),
+			},
+		},
+	})
+}
+
+func testAccClientSecretEphemeralResourceConfig(clientId string) string {
+	return fmt.Sprintf(`
+resource "rauthy_client" "test" {
+	id = %[1]q
+	name = %[1]q
+	confidential = true
+	redirect_uris = ["http://localhost/callback"]
+}
+
+ephemeral "rauthy_client_secret" "test" {
+	client_id = rauthy_client.test.id
+}
+
+provider "echo" {
+	data = ephemeral.rauthy_client_secret.test
+}
+
+resource "echo" "test" {}
+`, clientId)
+}
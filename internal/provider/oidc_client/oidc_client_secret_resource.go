@@ -4,10 +4,15 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/utils"
 	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
@@ -15,6 +20,8 @@ import (
 
 var _ resource.Resource = &OidcClientSecretResource{}
 var _ resource.ResourceWithImportState = &OidcClientSecretResource{}
+var _ resource.ResourceWithValidateConfig = &OidcClientSecretResource{}
+var _ resource.ResourceWithModifyPlan = &OidcClientSecretResource{}
 
 func NewOidcClientSecretResource() resource.Resource {
 	return &OidcClientSecretResource{}
@@ -28,11 +35,19 @@ func (r *OidcClientSecretResource) SetClient(c *rauthy.Client) {
 	r.client = c
 }
 
+// secretDriftedKey is the private state key set by Read when the live secret
+// differs from the one in state.
+const secretDriftedKey = "secret_drifted"
+
 type OidcClientSecretResourceModel struct {
 	Id                types.String `tfsdk:"id"`
 	ClientId          types.String `tfsdk:"client_id"`
 	CacheCurrentHours types.Int64  `tfsdk:"cache_current_hours"`
 	Secret            types.String `tfsdk:"secret"`
+	RotateAfter       types.String `tfsdk:"rotate_after"`
+	Keepers           types.Map    `tfsdk:"keepers"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	ExpiresGraceAt    types.String `tfsdk:"expires_grace_at"`
 }
 
 func (r *OidcClientSecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -49,8 +64,11 @@ func (r *OidcClientSecretResource) Schema(ctx context.Context, req resource.Sche
 				Required:            true,
 			},
 			"client_id": schema.StringAttribute{
-				MarkdownDescription: "Client ID",
+				MarkdownDescription: "Client ID. Changing this, including when the client is replaced with a new ID, issues a new secret",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"cache_current_hours": schema.Int64Attribute{
 				MarkdownDescription: "Cache current hours",
@@ -62,6 +80,35 @@ func (r *OidcClientSecretResource) Schema(ctx context.Context, req resource.Sche
 				MarkdownDescription: "Secret",
 				Computed:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotate_after": schema.StringAttribute{
+				MarkdownDescription: "Duration after which a new secret is issued, e.g. `720h`. The previous secret stays valid for `cache_current_hours`",
+				Optional:            true,
+			},
+			"keepers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that issue a new secret when changed. The previous secret stays valid for `cache_current_hours`",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 timestamp of when the secret was issued, or imported for imported secrets",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_grace_at": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 timestamp until which the previous secret is still accepted",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
@@ -71,6 +118,84 @@ func (r *OidcClientSecretResource) Configure(ctx context.Context, req resource.C
 	utils.ConfigureProvider(ctx, req, resp, r)
 }
 
+func (r *OidcClientSecretResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model OidcClientSecretResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() || model.RotateAfter.IsNull() || model.RotateAfter.IsUnknown() {
+		return
+	}
+
+	rotateAfter, err := time.ParseDuration(model.RotateAfter.ValueString())
+	if err != nil || rotateAfter <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rotate_after"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Expected a positive duration such as 720h, got: %s", model.RotateAfter.ValueString()),
+		)
+	}
+}
+
+func (r *OidcClientSecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan OidcClientSecretResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	drifted, diags := req.Private.GetKey(ctx, secretDriftedKey)
+	resp.Diagnostics.Append(diags...)
+
+	if string(drifted) == "true" {
+		resp.Diagnostics.AddWarning(
+			"Client Secret Changed Outside Terraform",
+			fmt.Sprintf("The secret of client %s no longer matches state, a new secret will be issued.", state.ClientId.ValueString()),
+		)
+		r.planRotation(ctx, &plan, path.Root("secret"), resp)
+		return
+	}
+
+	if plan.RotateAfter.IsNull() || plan.RotateAfter.IsUnknown() || state.CreatedAt.IsNull() {
+		return
+	}
+
+	rotateAfter, err := time.ParseDuration(plan.RotateAfter.ValueString())
+	if err != nil {
+		return
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, state.CreatedAt.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse created_at, got error: %s", err))
+		return
+	}
+
+	if time.Now().Before(createdAt.Add(rotateAfter)) {
+		return
+	}
+
+	r.planRotation(ctx, &plan, path.Root("created_at"), resp)
+}
+
+// planRotation marks the secret for replacement. reason must be one of the
+// computed attributes that become unknown, otherwise Terraform ignores it.
+func (r *OidcClientSecretResource) planRotation(ctx context.Context, plan *OidcClientSecretResourceModel, reason path.Path, resp *resource.ModifyPlanResponse) {
+	plan.Secret = types.StringUnknown()
+	plan.CreatedAt = types.StringUnknown()
+	plan.ExpiresGraceAt = types.StringUnknown()
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+	resp.RequiresReplace.Append(reason)
+}
+
 func (r *OidcClientSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model OidcClientSecretResourceModel
 
@@ -90,7 +215,11 @@ func (r *OidcClientSecretResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createdAt := time.Now().UTC()
+
 	model.Secret = types.StringValue(secret.Secret)
+	model.CreatedAt = types.StringValue(createdAt.Format(time.RFC3339))
+	model.ExpiresGraceAt = types.StringValue(createdAt.Add(time.Duration(model.CacheCurrentHours.ValueInt64()) * time.Hour).Format(time.RFC3339))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
@@ -104,18 +233,62 @@ func (r *OidcClientSecretResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	oidcClient, err := r.client.GetOidcClient(ctx, model.ClientId.ValueString())
+	if rauthy.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read client, got error: %s", err))
+		return
+	}
+
+	// Public clients have no secret, a new one is issued once the client is confidential again
+	if !oidcClient.Confidential {
+		resp.Diagnostics.AddWarning(
+			"Client Is Not Confidential",
+			fmt.Sprintf("Client %s is no longer confidential, its secret has been removed from state.", oidcClient.Id),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	secret, err := r.client.GetClientSecret(ctx, model.ClientId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read client secret, got error: %s", err))
+		return
+	}
+
+	// The secret is never known after import, adopt the live one
+	if model.Secret.IsNull() {
+		model.Secret = types.StringValue(secret.Secret)
+	}
+
+	drifted := []byte(nil)
+	if secret.Secret != model.Secret.ValueString() {
+		drifted = []byte("true")
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, secretDriftedKey, drifted)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
 func (r *OidcClientSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var model OidcClientSecretResourceModel
+	var model, state OidcClientSecretResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Only the rotation settings can change in place, the issued secret is kept
+	model.Secret = state.Secret
+	model.CreatedAt = state.CreatedAt
+	model.ExpiresGraceAt = state.ExpiresGraceAt
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -144,10 +317,16 @@ func (r *OidcClientSecretResource) ImportState(ctx context.Context, req resource
 		return
 	}
 
+	// Rauthy does not record when a secret was issued, rotate_after counts
+	// from the import instead
+	importedAt := types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
 	model := &OidcClientSecretResourceModel{
 		ClientId:          types.StringValue(clientId),
 		Id:                types.StringValue(id),
 		CacheCurrentHours: types.Int64Value(0),
+		CreatedAt:         importedAt,
+		ExpiresGraceAt:    importedAt,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
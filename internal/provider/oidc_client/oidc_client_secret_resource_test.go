@@ -1,14 +1,20 @@
 package oidc_client_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/acctest"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
 )
 
 func TestAccClientSecretResource(t *testing.T) {
@@ -17,7 +23,7 @@ func TestAccClientSecretResource(t *testing.T) {
 		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccClientSecretResourceConfig("testsecret"),
+				Config: testAccClientSecretResourceConfig("testsecret", "v1"),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(
 						"rauthy_client_secret.test",
@@ -34,6 +40,11 @@ func TestAccClientSecretResource(t *testing.T) {
 						tfjsonpath.New("secret"),
 						knownvalue.NotNull(),
 					),
+					statecheck.ExpectKnownValue(
+						"rauthy_client_secret.test",
+						tfjsonpath.New("created_at"),
+						knownvalue.NotNull(),
+					),
 				},
 			},
 			{
@@ -43,13 +54,188 @@ func TestAccClientSecretResource(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
 					"secret",
+					"keepers",
+					"created_at",
+					"expires_grace_at",
+				},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if states[0].Attributes["created_at"] == "" {
+						return fmt.Errorf("expected created_at to be set on import, so rotate_after applies")
+					}
+
+					return nil
+				},
+			},
+			{
+				Config: testAccClientSecretResourceConfig("testsecret", "v2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("rauthy_client_secret.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccClientSecretResource_RotateAfter(t *testing.T) {
+	expiresGraceAt := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClientSecretResourceRotateConfig("testrotate", "5s"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					expiresGraceAt.AddStateValue("rauthy_client_secret.test", tfjsonpath.New("expires_grace_at")),
+				},
+			},
+			{
+				PreConfig: func() { time.Sleep(6 * time.Second) },
+				Config:    testAccClientSecretResourceRotateConfig("testrotate", "5s"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("rauthy_client_secret.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					expiresGraceAt.AddStateValue("rauthy_client_secret.test", tfjsonpath.New("expires_grace_at")),
+				},
+			},
+		},
+	})
+}
+
+// Changes made in Rauthy behind Terraform's back are picked up on refresh.
+func TestAccClientSecretResource_OutOfBand(t *testing.T) {
+	ctx := context.Background()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClientSecretResourceConfig("testoutofband", "v1"),
+			},
+			// A secret rotated outside Terraform is replaced
+			{
+				PreConfig: func() {
+					if _, err := acctest.TestAccClient().CreateClientSecret(ctx, "testoutofband", &rauthy.ClientSecretRequest{}); err != nil {
+						t.Fatalf("unable to rotate client secret: %s", err)
+					}
+				},
+				Config: testAccClientSecretResourceConfig("testoutofband", "v1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("rauthy_client_secret.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
+			// A client that is no longer confidential drops the secret from state
+			{
+				PreConfig: func() {
+					client := acctest.TestAccClient()
+
+					oidcClient, err := client.GetOidcClient(ctx, "testoutofband")
+					if err != nil {
+						t.Fatalf("unable to read client: %s", err)
+					}
+
+					oidcClient.Confidential = false
+
+					if _, err := client.UpdateOidcClient(ctx, "testoutofband", &oidcClient); err != nil {
+						t.Fatalf("unable to update client: %s", err)
+					}
+				},
+				Config: testAccClientSecretResourceConfig("testoutofband", "v1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("rauthy_client.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("rauthy_client_secret.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+// The client is created through the API, rauthy_client itself fails to
+// refresh once it is deleted.
+func TestAccClientSecretResource_ClientDeleted(t *testing.T) {
+	ctx := context.Background()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+
+			_, err := acctest.TestAccClient().CreateOidcClient(ctx, &rauthy.CreateOidcClientPayload{
+				Id:           "testclientdeleted",
+				Name:         "testclientdeleted",
+				Confidential: true,
+				RedirectUris: []string{"http://localhost/callback"},
+			})
+			if err != nil {
+				t.Fatalf("unable to create client: %s", err)
+			}
+
+			t.Cleanup(func() {
+				if err := acctest.TestAccClient().DeleteOidcClient(ctx, "testclientdeleted"); err != nil && !rauthy.IsNotFound(err) {
+					t.Errorf("unable to delete client: %s", err)
+				}
+			})
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "rauthy_client_secret" "test" {
+	id = "one"
+	client_id = "testclientdeleted"
+}
+`,
+			},
+			{
+				PreConfig: func() {
+					if err := acctest.TestAccClient().DeleteOidcClient(ctx, "testclientdeleted"); err != nil {
+						t.Fatalf("unable to delete client: %s", err)
+					}
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				RefreshPlanChecks: resource.RefreshPlanChecks{
+					PostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("rauthy_client_secret.test", plancheck.ResourceActionCreate),
+					},
 				},
 			},
 		},
 	})
 }
 
-func testAccClientSecretResourceConfig(clientId string) string {
+func testAccClientSecretResourceConfig(clientId string, keeper string) string {
+	return fmt.Sprintf(`
+resource "rauthy_client" "test" {
+	id = %[1]q
+	name = %[1]q
+	confidential = true
+	redirect_uris = ["http://localhost/callback"]
+}
+
+resource "rauthy_client_secret" "test" {
+	id = "one"
+	client_id = rauthy_client.test.id
+	cache_current_hours = 1
+	rotate_after = "720h"
+
+	keepers = {
+		version = %[2]q
+	}
+}
+`, clientId, keeper)
+}
+
+func testAccClientSecretResourceRotateConfig(clientId string, rotateAfter string) string {
 	return fmt.Sprintf(`
 resource "rauthy_client" "test" {
 	id = %[1]q
@@ -61,6 +247,8 @@ resource "rauthy_client" "test" {
 resource "rauthy_client_secret" "test" {
 	id = "one"
 	client_id = rauthy_client.test.id
+	cache_current_hours = 1
+	rotate_after = %[2]q
 }
-`, clientId)
+`, clientId, rotateAfter)
 }
@@ -0,0 +1,238 @@
+package oidc_client
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/utils"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/tfutils"
+)
+
+var _ datasource.DataSource = &OidcClientsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &OidcClientsDataSource{}
+
+func NewOidcClientsDataSource() datasource.DataSource {
+	return &OidcClientsDataSource{}
+}
+
+type OidcClientsDataSource struct {
+	client *rauthy.Client
+}
+
+func (d *OidcClientsDataSource) SetClient(c *rauthy.Client) {
+	d.client = c
+}
+
+type OidcClientsDataSourceModel struct {
+	Enabled      types.Bool                         `tfsdk:"enabled"`
+	Confidential types.Bool                         `tfsdk:"confidential"`
+	Flow         types.String                       `tfsdk:"flow"`
+	Scope        types.String                       `tfsdk:"scope"`
+	NameRegex    types.String                       `tfsdk:"name_regex"`
+	Ids          types.List                         `tfsdk:"ids"`
+	Clients      []OidcClientsDataSourceClientModel `tfsdk:"clients"`
+}
+
+type OidcClientsDataSourceClientModel struct {
+	Id                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	Confidential           types.Bool   `tfsdk:"confidential"`
+	RedirectUris           types.List   `tfsdk:"redirect_uris"`
+	PostLogoutRedirectUris types.List   `tfsdk:"post_logout_redirect_uris"`
+	FlowsEnabled           types.List   `tfsdk:"flows_enabled"`
+	Scopes                 types.List   `tfsdk:"scopes"`
+	DefaultScopes          types.List   `tfsdk:"default_scopes"`
+	Challenges             types.List   `tfsdk:"challenges"`
+	ForceMfa               types.Bool   `tfsdk:"force_mfa"`
+}
+
+func (d *OidcClientsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clients"
+}
+
+func (d *OidcClientsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "OIDC Clients data source. All filters are optional and combined with AND",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Only return clients with this enabled state",
+				Optional:            true,
+			},
+			"confidential": schema.BoolAttribute{
+				MarkdownDescription: "Only return clients with this confidential state",
+				Optional:            true,
+			},
+			"flow": schema.StringAttribute{
+				MarkdownDescription: "Only return clients with this flow enabled, e.g. `client_credentials`",
+				Optional:            true,
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Only return clients allowed to request this scope",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return clients whose name matches this regular expression",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the matching clients",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"clients": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching clients",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the client",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the client",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the client is enabled",
+							Computed:            true,
+						},
+						"confidential": schema.BoolAttribute{
+							MarkdownDescription: "Whether the client is confidential",
+							Computed:            true,
+						},
+						"redirect_uris": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Redirect URIs",
+							Computed:            true,
+						},
+						"post_logout_redirect_uris": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Post logout redirect URIs",
+							Computed:            true,
+						},
+						"flows_enabled": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Enabled flows",
+							Computed:            true,
+						},
+						"scopes": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Scopes",
+							Computed:            true,
+						},
+						"default_scopes": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Default scopes",
+							Computed:            true,
+						},
+						"challenges": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "PKCE challenges, empty when PKCE is not required",
+							Computed:            true,
+						},
+						"force_mfa": schema.BoolAttribute{
+							MarkdownDescription: "Force MFA",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OidcClientsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	utils.ConfigureDataSource(ctx, req, resp, d)
+}
+
+func (d *OidcClientsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data OidcClientsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.NameRegex.IsNull() || data.NameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(data.NameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Unable to compile regular expression, got error: %s", err),
+		)
+	}
+}
+
+func (d *OidcClientsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OidcClientsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oidcClients, err := d.client.GetOidcClients(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OIDC clients, got error: %s", err))
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		nameRegex = regexp.MustCompile(data.NameRegex.ValueString())
+	}
+
+	ids := []string{}
+	data.Clients = []OidcClientsDataSourceClientModel{}
+
+	for _, c := range oidcClients {
+		if !data.Enabled.IsNull() && c.Enabled != data.Enabled.ValueBool() {
+			continue
+		}
+
+		if !data.Confidential.IsNull() && c.Confidential != data.Confidential.ValueBool() {
+			continue
+		}
+
+		if !data.Flow.IsNull() && !slices.Contains(c.FlowsEnabled, data.Flow.ValueString()) {
+			continue
+		}
+
+		if !data.Scope.IsNull() && !slices.Contains(c.Scopes, data.Scope.ValueString()) {
+			continue
+		}
+
+		if nameRegex != nil && !nameRegex.MatchString(c.Name) {
+			continue
+		}
+
+		ids = append(ids, c.Id)
+		data.Clients = append(data.Clients, OidcClientsDataSourceClientModel{
+			Id:                     types.StringValue(c.Id),
+			Name:                   types.StringValue(c.Name),
+			Enabled:                types.BoolValue(c.Enabled),
+			Confidential:           types.BoolValue(c.Confidential),
+			RedirectUris:           tfutils.StringSliceToList(c.RedirectUris),
+			PostLogoutRedirectUris: tfutils.StringSliceToList(c.PostLogoutUri),
+			FlowsEnabled:           tfutils.StringSliceToList(c.FlowsEnabled),
+			Scopes:                 tfutils.StringSliceToList(c.Scopes),
+			DefaultScopes:          tfutils.StringSliceToList(c.DefaultScopes),
+			Challenges:             tfutils.StringSliceToList(c.Challenges),
+			ForceMfa:               types.BoolValue(c.ForceMfa),
+		})
+	}
+
+	data.Ids = tfutils.StringSliceToList(ids)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,52 @@
+package oidc_client_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/acctest"
+)
+
+func TestAccOidcClientsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOidcClientsDataSourceConfig("test-clients-ds"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.rauthy_clients.test", "clients.#", "1"),
+					resource.TestCheckResourceAttrPair("data.rauthy_clients.test", "clients.0.id", "rauthy_client.confidential", "id"),
+					resource.TestCheckResourceAttr("data.rauthy_clients.test", "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOidcClientsDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "rauthy_client" "confidential" {
+	id = "%[1]s-confidential"
+	name = "%[1]s-confidential"
+	confidential = true
+	redirect_uris = ["http://localhost:8080"]
+}
+
+resource "rauthy_client" "public" {
+	id = "%[1]s-public"
+	name = "%[1]s-public"
+	redirect_uris = ["http://localhost:8080"]
+}
+
+data "rauthy_clients" "test" {
+	enabled = true
+	confidential = true
+	flow = "authorization_code"
+	name_regex = "^%[1]s-"
+
+	depends_on = [rauthy_client.confidential, rauthy_client.public]
+}
+`, name)
+}
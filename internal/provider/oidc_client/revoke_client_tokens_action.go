@@ -0,0 +1,86 @@
+package oidc_client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/utils"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+)
+
+var _ action.Action = &RevokeClientTokensAction{}
+var _ action.ActionWithConfigure = &RevokeClientTokensAction{}
+
+func NewRevokeClientTokensAction() action.Action {
+	return &RevokeClientTokensAction{}
+}
+
+type RevokeClientTokensAction struct {
+	client *rauthy.Client
+}
+
+func (a *RevokeClientTokensAction) SetClient(c *rauthy.Client) {
+	a.client = c
+}
+
+type RevokeClientTokensActionModel struct {
+	ClientId types.String `tfsdk:"client_id"`
+}
+
+func (a *RevokeClientTokensAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_revoke_client_tokens"
+}
+
+func (a *RevokeClientTokensAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Invalidates every session and refresh token issued to a client, e.g. after rotating a " +
+			"compromised secret. Access tokens already issued stay valid until they expire",
+
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "Client ID",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (a *RevokeClientTokensAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	utils.ConfigureAction(ctx, req, resp, a)
+}
+
+func (a *RevokeClientTokensAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data RevokeClientTokensActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientId := data.ClientId.ValueString()
+
+	if _, err := a.client.GetOidcClient(ctx, clientId); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read client %s, got error: %s", clientId, err))
+		return
+	}
+
+	sessions, err := a.client.GetClientSessions(ctx, clientId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list sessions of client %s, got error: %s", clientId, err))
+		return
+	}
+
+	if err := a.client.RevokeClientTokens(ctx, clientId); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke tokens of client %s, got error: %s", clientId, err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Revoked %d session(s) and the refresh tokens of client %s", len(sessions), clientId),
+	})
+}
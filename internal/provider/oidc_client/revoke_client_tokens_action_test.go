@@ -0,0 +1,126 @@
+package oidc_client_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/acctest"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+)
+
+// A refresh token issued before the action runs must be rejected afterwards.
+// The password grant needs an existing user, given by RAUTHY_TEST_USERNAME
+// and RAUTHY_TEST_PASSWORD.
+func TestAccRevokeClientTokensAction(t *testing.T) {
+	var refreshToken string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+
+			if os.Getenv("RAUTHY_TEST_USERNAME") == "" || os.Getenv("RAUTHY_TEST_PASSWORD") == "" {
+				t.Skip("RAUTHY_TEST_USERNAME and RAUTHY_TEST_PASSWORD must be set to issue a refresh token")
+			}
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRevokeClientTokensActionConfig(false),
+				Check: func(s *terraform.State) error {
+					token, err := testAccIssueRefreshableToken(s)
+					if err != nil {
+						return err
+					}
+
+					refreshToken = token.RefreshToken
+
+					if refreshToken == "" {
+						return fmt.Errorf("expected a refresh token to be issued")
+					}
+
+					return nil
+				},
+			},
+			{
+				Config: testAccRevokeClientTokensActionConfig(true),
+				Check: func(s *terraform.State) error {
+					secret := s.RootModule().Resources["rauthy_client_secret.app"].Primary.Attributes["secret"]
+
+					_, err := acctest.TestAccClient().IssueToken(context.Background(), &rauthy.TokenRequest{
+						GrantType:    rauthy.GrantTypeRefreshToken,
+						ClientId:     "revoke-tokens",
+						ClientSecret: secret,
+						RefreshToken: refreshToken,
+					})
+
+					if err == nil {
+						return fmt.Errorf("expected the refresh token to be rejected after revocation")
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccIssueRefreshableToken(s *terraform.State) (*rauthy.TokenResponse, error) {
+	secret := s.RootModule().Resources["rauthy_client_secret.app"].Primary.Attributes["secret"]
+
+	return acctest.TestAccClient().IssueToken(context.Background(), &rauthy.TokenRequest{
+		GrantType:    rauthy.GrantTypePassword,
+		ClientId:     "revoke-tokens",
+		ClientSecret: secret,
+		Username:     os.Getenv("RAUTHY_TEST_USERNAME"),
+		Password:     os.Getenv("RAUTHY_TEST_PASSWORD"),
+		Scopes:       []string{"openid"},
+	})
+}
+
+func testAccRevokeClientTokensActionConfig(revoke bool) string {
+	config := `
+resource "rauthy_client" "app" {
+	id = "revoke-tokens"
+	name = "revoke-tokens"
+	confidential = true
+	redirect_uris = ["http://localhost/callback"]
+	flows_enabled = ["password", "refresh_token"]
+}
+
+resource "rauthy_client_secret" "app" {
+	id = "one"
+	client_id = rauthy_client.app.id
+}
+
+action "rauthy_revoke_client_tokens" "app" {
+	config {
+		client_id = rauthy_client.app.id
+	}
+}
+`
+
+	if revoke {
+		config += `
+resource "terraform_data" "rotation" {
+	input = "1"
+
+	lifecycle {
+		action_trigger {
+			events  = [after_create]
+			actions = [action.rauthy_revoke_client_tokens.app]
+		}
+	}
+}
+`
+	}
+
+	return config
+}
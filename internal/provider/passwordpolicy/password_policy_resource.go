@@ -81,13 +81,13 @@ func (r *PasswordPolicyResource) Schema(ctx context.Context, req resource.Schema
 				Required:            true,
 			},
 			"not_recently_used": schema.Int64Attribute{
-				MarkdownDescription: "Number of previous passwords that cannot be reused",
+				MarkdownDescription: "Number of previous passwords that cannot be reused, 0 disables the check",
 				Optional:            true,
 				Default:             int64default.StaticInt64(3),
 				Computed:            true,
 			},
 			"valid_days": schema.Int64Attribute{
-				MarkdownDescription: "Number of days before password expires",
+				MarkdownDescription: "Number of days before password expires, 0 disables expiry",
 				Optional:            true,
 				Default:             int64default.StaticInt64(180),
 				Computed:            true,
@@ -144,6 +144,15 @@ func (r *PasswordPolicyResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	passwordPolicy, err := r.client.GetPasswordPolicy(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read password policy, got error: %s", err))
+		return
+	}
+
+	// Changes made in the admin UI show up as drift in the next plan
+	data.FromApiResource(passwordPolicy)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -188,7 +197,18 @@ func (model *PasswordPolicyResourceModel) ToPayload() *rauthy.PasswordPolicy {
 		IncludeSpecial:   int(model.IncludeSpecial.ValueInt64()),
 		LengthMin:        int(model.LengthMin.ValueInt64()),
 		LengthMax:        int(model.LengthMax.ValueInt64()),
-		// NotRecentlyUsed:  int(model.NotRecentlyUsed.ValueInt64()),
-		ValidDays: int(model.ValidDays.ValueInt64()),
+		NotRecentlyUsed:  int(model.NotRecentlyUsed.ValueInt64()),
+		ValidDays:        int(model.ValidDays.ValueInt64()),
 	}
 }
+
+func (model *PasswordPolicyResourceModel) FromApiResource(passwordPolicy *rauthy.PasswordPolicy) {
+	model.IncludeUpperCase = types.Int64Value(int64(passwordPolicy.IncludeUpperCase))
+	model.IncludeLowerCase = types.Int64Value(int64(passwordPolicy.IncludeLowerCase))
+	model.IncludeDigits = types.Int64Value(int64(passwordPolicy.IncludeDigits))
+	model.IncludeSpecial = types.Int64Value(int64(passwordPolicy.IncludeSpecial))
+	model.LengthMin = types.Int64Value(int64(passwordPolicy.LengthMin))
+	model.LengthMax = types.Int64Value(int64(passwordPolicy.LengthMax))
+	model.NotRecentlyUsed = types.Int64Value(int64(passwordPolicy.NotRecentlyUsed))
+	model.ValidDays = types.Int64Value(int64(passwordPolicy.ValidDays))
+}
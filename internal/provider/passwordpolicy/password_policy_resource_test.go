@@ -4,14 +4,17 @@
 package passwordpolicy_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/acctest"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
 )
 
 func TestAccPasswordPolicyResource(t *testing.T) {
@@ -109,6 +112,39 @@ func TestAccPasswordPolicyResource(t *testing.T) {
 					),
 				},
 			},
+			{
+				PreConfig: func() {
+					_, err := acctest.TestAccClient().UpdatePasswordPolicy(context.Background(), &rauthy.PasswordPolicy{
+						LengthMin:        8,
+						LengthMax:        128,
+						IncludeDigits:    1,
+						IncludeLowerCase: 1,
+						IncludeUpperCase: 1,
+						ValidDays:        365,
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccPasswordPolicyResourceConfig(20, 128, 365),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("rauthy_password_policy.default", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(
+							"rauthy_password_policy.default",
+							tfjsonpath.New("length_min"),
+							knownvalue.Int64Exact(20),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"rauthy_password_policy.default",
+						tfjsonpath.New("include_special"),
+						knownvalue.Int64Exact(1),
+					),
+				},
+			},
 		},
 	})
 }
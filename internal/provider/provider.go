@@ -21,6 +21,7 @@ import (
 	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/oidc_client"
 	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/passwordpolicy"
 	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/role"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/token"
 	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
 )
 
@@ -58,8 +59,9 @@ func (p *RauthyProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Optional:            true,
 			},
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "Example provider attribute",
+				MarkdownDescription: "Admin API key. Not required when only `rauthy_dynamic_client` is used",
 				Optional:            true,
+				Sensitive:           true,
 			},
 			"insecure": schema.BoolAttribute{
 				MarkdownDescription: "Example provider attribute",
@@ -91,12 +93,16 @@ func (p *RauthyProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ActionData = client
 }
 
 func (p *RauthyProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		oidc_client.NewOidcClientResource,
 		oidc_client.NewOidcClientSecretResource,
+		oidc_client.NewDynamicClientResource,
+		oidc_client.NewEphemeralClientResource,
 		auth_provider.NewAuthProviderResource,
 		passwordpolicy.NewPasswordPolicyResource,
 		role.NewRoleResource,
@@ -106,7 +112,8 @@ func (p *RauthyProvider) Resources(ctx context.Context) []func() resource.Resour
 
 func (p *RauthyProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
 	return []func() ephemeral.EphemeralResource{
-		// NewExampleEphemeralResource,
+		oidc_client.NewOidcClientSecretEphemeralResource,
+		token.NewAccessTokenEphemeralResource,
 	}
 }
 
@@ -115,19 +122,24 @@ func (p *RauthyProvider) DataSources(ctx context.Context) []func() datasource.Da
 		group.NewGroupDataSource,
 		role.NewRoleDataSource,
 		oidc_client.NewOidcClientDataSource,
+		oidc_client.NewOidcClientsDataSource,
+		oidc_client.NewOidcClientConfigDataSource,
 		auth_provider.NewAuthProviderDataSource,
+		auth_provider.NewAuthProvidersDataSource,
 	}
 }
 
 func (p *RauthyProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
-		// NewExampleFunction,
+		auth_provider.NewEvaluateClaimPathFunction,
 	}
 }
 
 func (p *RauthyProvider) Actions(ctx context.Context) []func() action.Action {
 	return []func() action.Action{
-		// NewExampleAction,
+		oidc_client.NewRevokeClientTokensAction,
+		auth_provider.NewLinkUserProviderAction,
+		auth_provider.NewUnlinkUserProviderAction,
 	}
 }
 
@@ -170,9 +182,5 @@ func (c *ProviderConfig) Validate() error {
 		return fmt.Errorf("`endpoint` or `RAUTHY_ENDPOINT` is required")
 	}
 
-	if c.APIKey == "" {
-		return fmt.Errorf("api_key` or `RAUTHY_API_KEY` is required")
-	}
-
 	return nil
 }
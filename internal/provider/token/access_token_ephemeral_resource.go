@@ -0,0 +1,215 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/utils"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/tfutils"
+)
+
+var _ ephemeral.EphemeralResource = &AccessTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &AccessTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithValidateConfig = &AccessTokenEphemeralResource{}
+
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &AccessTokenEphemeralResource{}
+}
+
+type AccessTokenEphemeralResource struct {
+	client *rauthy.Client
+}
+
+func (r *AccessTokenEphemeralResource) SetClient(c *rauthy.Client) {
+	r.client = c
+}
+
+type AccessTokenEphemeralResourceModel struct {
+	GrantType     types.String `tfsdk:"grant_type"`
+	ClientId      types.String `tfsdk:"client_id"`
+	ClientSecret  types.String `tfsdk:"client_secret"`
+	Username      types.String `tfsdk:"username"`
+	Password      types.String `tfsdk:"password"`
+	Scopes        types.Set    `tfsdk:"scopes"`
+	AccessToken   types.String `tfsdk:"access_token"`
+	IdToken       types.String `tfsdk:"id_token"`
+	TokenType     types.String `tfsdk:"token_type"`
+	ExpiresIn     types.Int64  `tfsdk:"expires_in"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+	Claims        types.String `tfsdk:"claims"`
+	IdTokenClaims types.String `tfsdk:"id_token_claims"`
+}
+
+func (r *AccessTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (r *AccessTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Issues a token from the configured Rauthy instance without persisting it",
+
+		Attributes: map[string]schema.Attribute{
+			"grant_type": schema.StringAttribute{
+				MarkdownDescription: "Grant to run, `client_credentials` (default) or `password`",
+				Optional:            true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "Client ID",
+				Required:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "Client secret, required for the `client_credentials` grant",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "E-mail of the user, required for the `password` grant",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of the user, required for the `password` grant",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"scopes": schema.SetAttribute{
+				MarkdownDescription: "Scopes to request",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "Access token",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"id_token": schema.StringAttribute{
+				MarkdownDescription: "ID token, only issued when the `openid` scope is granted",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"token_type": schema.StringAttribute{
+				MarkdownDescription: "Token type",
+				Computed:            true,
+			},
+			"expires_in": schema.Int64Attribute{
+				MarkdownDescription: "Seconds until the access token expires",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 timestamp of when the access token expires",
+				Computed:            true,
+			},
+			"claims": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded claims of the access token, use `jsondecode` to read them",
+				Computed:            true,
+			},
+			"id_token_claims": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded claims of the ID token",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AccessTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	utils.ConfigureEphemeralResource(ctx, req, resp, r)
+}
+
+func (r *AccessTokenEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var model AccessTokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() || model.GrantType.IsUnknown() {
+		return
+	}
+
+	switch model.grantType() {
+	case rauthy.GrantTypeClientCredentials:
+		if model.ClientSecret.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("client_secret"),
+				"Missing Attribute",
+				"`client_secret` is required for the client_credentials grant",
+			)
+		}
+	case rauthy.GrantTypePassword:
+		if model.Username.IsNull() || model.Password.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("username"),
+				"Missing Attribute",
+				"`username` and `password` are required for the password grant",
+			)
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("grant_type"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Expected client_credentials or password, got: %s", model.GrantType.ValueString()),
+		)
+	}
+}
+
+func (r *AccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model AccessTokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.client.IssueToken(ctx, &rauthy.TokenRequest{
+		GrantType:    model.grantType(),
+		ClientId:     model.ClientId.ValueString(),
+		ClientSecret: model.ClientSecret.ValueString(),
+		Username:     model.Username.ValueString(),
+		Password:     model.Password.ValueString(),
+		Scopes:       tfutils.SetToStringSlice(model.Scopes),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to issue token, got error: %s", err))
+		return
+	}
+
+	model.AccessToken = types.StringValue(token.AccessToken)
+	model.TokenType = types.StringValue(token.TokenType)
+	model.ExpiresIn = types.Int64Value(token.ExpiresIn)
+	model.ExpiresAt = types.StringValue(time.Now().UTC().Add(time.Duration(token.ExpiresIn) * time.Second).Format(time.RFC3339))
+	model.IdToken = types.StringNull()
+	model.IdTokenClaims = types.StringNull()
+
+	claims, err := rauthy.DecodeJwtClaims(token.AccessToken)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to decode access token claims, got error: %s", err))
+		return
+	}
+
+	model.Claims = types.StringValue(claims)
+
+	if token.IdToken != "" {
+		idTokenClaims, err := rauthy.DecodeJwtClaims(token.IdToken)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to decode ID token claims, got error: %s", err))
+			return
+		}
+
+		model.IdToken = types.StringValue(token.IdToken)
+		model.IdTokenClaims = types.StringValue(idTokenClaims)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
+
+func (m *AccessTokenEphemeralResourceModel) grantType() string {
+	if m.GrantType.IsNull() {
+		return rauthy.GrantTypeClientCredentials
+	}
+
+	return m.GrantType.ValueString()
+}
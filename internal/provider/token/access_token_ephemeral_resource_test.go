@@ -0,0 +1,72 @@
+package token_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/moonlight8978/terraform-provider-rauthy/internal/provider/acctest"
+)
+
+func TestAccAccessTokenEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessTokenEphemeralResourceConfig("access-token"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("token_type"),
+						knownvalue.StringExact("Bearer"),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("access_token"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("claims"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccAccessTokenEphemeralResourceConfig(clientId string) string {
+	return fmt.Sprintf(`
+resource "rauthy_client" "test" {
+	id = %[1]q
+	name = %[1]q
+	confidential = true
+	flows_enabled = ["client_credentials"]
+}
+
+resource "rauthy_client_secret" "test" {
+	id = "one"
+	client_id = rauthy_client.test.id
+}
+
+ephemeral "rauthy_access_token" "test" {
+	client_id = rauthy_client.test.id
+	client_secret = rauthy_client_secret.test.secret
+}
+
+provider "echo" {
+	data = ephemeral.rauthy_access_token.test
+}
+
+resource "echo" "test" {}
+`, clientId)
+}
@@ -4,6 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
@@ -14,22 +18,40 @@ type Resource interface {
 }
 
 func ConfigureProvider[TResource Resource](ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse, resource TResource) {
-	if req.ProviderData == nil {
+	configureClient("Resource", req.ProviderData, &resp.Diagnostics, resource)
+}
+
+func ConfigureDataSource[TResource Resource](ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse, dataSource TResource) {
+	configureClient("Data Source", req.ProviderData, &resp.Diagnostics, dataSource)
+}
+
+func ConfigureEphemeralResource[TResource Resource](ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse, resource TResource) {
+	configureClient("Ephemeral Resource", req.ProviderData, &resp.Diagnostics, resource)
+}
+
+func ConfigureAction[TResource Resource](ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse, action TResource) {
+	configureClient("Action", req.ProviderData, &resp.Diagnostics, action)
+}
+
+// configureClient hands the provider's client to target. kind names the
+// configured type in the error, e.g. "Data Source".
+func configureClient(kind string, providerData any, diags *diag.Diagnostics, target Resource) {
+	if providerData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*rauthy.Client)
+	client, ok := providerData.(*rauthy.Client)
 
 	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *rauthy.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		diags.AddError(
+			fmt.Sprintf("Unexpected %s Configure Type", kind),
+			fmt.Sprintf("Expected *rauthy.Client, got: %T. Please report this issue to the provider developers.", providerData),
 		)
 
 		return
 	}
 
-	resource.SetClient(client)
+	target.SetClient(client)
 }
 
 // StringPtrToFramework converts a nullable API string pointer to a Terraform Framework types.String.
@@ -50,3 +72,12 @@ func FrameworkToStringPtr(v types.String) *string {
 	s := v.ValueString()
 	return &s
 }
+
+// EmptyToNil converts an API string that is empty when unset to a nullable string pointer.
+// Returns nil if the string is empty, otherwise a pointer to it.
+func EmptyToNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -1,10 +1,34 @@
 package rauthy
 
 import (
+	"bytes"
 	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"unicode"
 )
 
+const (
+	AuthProviderTypeCustom    = "custom"
+	AuthProviderTypeGithub    = "github"
+	AuthProviderTypeGitlab    = "gitlab"
+	AuthProviderTypeGoogle    = "google"
+	AuthProviderTypeMicrosoft = "microsoft"
+)
+
+// AuthProviderTypes lists the values of `typ` Rauthy accepts.
+var AuthProviderTypes = []string{
+	AuthProviderTypeCustom,
+	AuthProviderTypeGithub,
+	AuthProviderTypeGitlab,
+	AuthProviderTypeGoogle,
+	AuthProviderTypeMicrosoft,
+}
+
 type AuthProvider struct {
 	AdminClaimPath        *string `json:"admin_claim_path,omitempty"`
 	AdminClaimValue       *string `json:"admin_claim_value,omitempty"`
@@ -15,6 +39,7 @@ type AuthProvider struct {
 	ClientSecret          string  `json:"client_secret"`
 	ClientSecretBasic     bool    `json:"client_secret_basic"`
 	ClientSecretPost      bool    `json:"client_secret_post"`
+	DangerAllowInsecure   bool    `json:"danger_allow_insecure"`
 	Enabled               bool    `json:"enabled"`
 	Id                    string  `json:"id"`
 	Issuer                string  `json:"issuer"`
@@ -22,6 +47,7 @@ type AuthProvider struct {
 	MfaClaimPath          *string `json:"mfa_claim_path,omitempty"`
 	MfaClaimValue         *string `json:"mfa_claim_value,omitempty"`
 	Name                  string  `json:"name"`
+	RootPem               *string `json:"root_pem,omitempty"`
 	Scope                 string  `json:"scope"`
 	TokenEndpoint         string  `json:"token_endpoint"`
 	Typ                   string  `json:"typ"`
@@ -29,6 +55,72 @@ type AuthProvider struct {
 	UserinfoEndpoint      string  `json:"userinfo_endpoint"`
 }
 
+// ParseScope splits a scope in Rauthy's `+` separated format, or the space
+// separated format of OAuth, into sorted unique scopes.
+func ParseScope(scope string) []string {
+	scopes := splitScope(scope)
+
+	slices.Sort(scopes)
+
+	return slices.Compact(scopes)
+}
+
+// FormatScope joins unique scopes in their given order into the space
+// separated format Rauthy accepts. Rauthy returns them joined with `+`.
+func FormatScope(scopes []string) string {
+	unique := []string{}
+
+	for _, scope := range splitScope(strings.Join(scopes, " ")) {
+		if !slices.Contains(unique, scope) {
+			unique = append(unique, scope)
+		}
+	}
+
+	return strings.Join(unique, " ")
+}
+
+func splitScope(scope string) []string {
+	return strings.FieldsFunc(scope, func(r rune) bool {
+		return r == '+' || unicode.IsSpace(r)
+	})
+}
+
+// ValidateRootPem checks that chain is a chain of one or more PEM encoded
+// certificates, as Rauthy expects for `root_pem`.
+func ValidateRootPem(chain string) error {
+	rest := []byte(chain)
+	count := 0
+
+	for {
+		var block *pem.Block
+
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		count++
+
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("block %d is a %s, expected a CERTIFICATE", count, block.Type)
+		}
+
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return fmt.Errorf("block %d is not a valid certificate: %w", count, err)
+		}
+	}
+
+	if count == 0 {
+		return errors.New("no PEM encoded certificate found")
+	}
+
+	if len(bytes.TrimSpace(rest)) > 0 {
+		return fmt.Errorf("unexpected content after certificate %d", count)
+	}
+
+	return nil
+}
+
 func (c *Client) CreateAuthProvider(ctx context.Context, provider *AuthProvider) (*AuthProvider, error) {
 	var newProvider AuthProvider
 	_, err := c.Request(ctx, "POST", "/providers/create", &provider, &newProvider)
@@ -40,7 +132,7 @@ func (c *Client) CreateAuthProvider(ctx context.Context, provider *AuthProvider)
 	return &newProvider, nil
 }
 
-func (c *Client) GetAuthProvider(ctx context.Context, id string) (*AuthProvider, error) {
+func (c *Client) GetAuthProviders(ctx context.Context) ([]AuthProvider, error) {
 	var providers []AuthProvider
 	_, err := c.Request(ctx, "POST", "/providers", nil, &providers)
 
@@ -48,20 +140,23 @@ func (c *Client) GetAuthProvider(ctx context.Context, id string) (*AuthProvider,
 		return nil, err
 	}
 
-	var provider AuthProvider
+	return providers, nil
+}
+
+func (c *Client) GetAuthProvider(ctx context.Context, id string) (*AuthProvider, error) {
+	providers, err := c.GetAuthProviders(ctx)
+
+	if err != nil {
+		return nil, err
+	}
 
 	for _, p := range providers {
 		if p.Id == id {
-			provider = p
-			break
+			return &p, nil
 		}
 	}
 
-	if provider == (AuthProvider{}) {
-		return nil, fmt.Errorf("no provider found with id %s", id)
-	}
-
-	return &provider, nil
+	return nil, fmt.Errorf("no provider found with id %s", id)
 }
 
 func (c *Client) UpdateAuthProvider(ctx context.Context, id string, provider *AuthProvider) (*AuthProvider, error) {
@@ -75,6 +170,25 @@ func (c *Client) UpdateAuthProvider(ctx context.Context, id string, provider *Au
 	return &updatedProvider, nil
 }
 
+// AuthProviderLinkedUser is a user federated through an auth provider.
+type AuthProviderLinkedUser struct {
+	Id    string `json:"id"`
+	Email string `json:"email"`
+}
+
+// GetAuthProviderLinkedUsers runs Rauthy's safe-delete check and returns the
+// users who would lose their login if the provider was deleted.
+func (c *Client) GetAuthProviderLinkedUsers(ctx context.Context, id string) ([]AuthProviderLinkedUser, error) {
+	var users []AuthProviderLinkedUser
+	_, err := c.Request(ctx, "GET", fmt.Sprintf("/providers/%s/delete_safe", id), nil, &users)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
 func (c *Client) DeleteAuthProvider(ctx context.Context, id string) error {
 	_, err := c.Request(ctx, "DELETE", fmt.Sprintf("/providers/%s", id), nil, nil)
 
@@ -84,3 +198,36 @@ func (c *Client) DeleteAuthProvider(ctx context.Context, id string) error {
 
 	return nil
 }
+
+type AuthProviderLookupRequest struct {
+	Issuer              string  `json:"issuer"`
+	RootPem             *string `json:"root_pem,omitempty"`
+	DangerAllowInsecure bool    `json:"danger_allow_insecure"`
+}
+
+// AuthProviderLookup holds the settings Rauthy resolved from the
+// discovery document of an upstream issuer.
+type AuthProviderLookup struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	JwksEndpoint          string `json:"jwks_endpoint"`
+	ClientSecretBasic     bool   `json:"client_secret_basic"`
+	ClientSecretPost      bool   `json:"client_secret_post"`
+	UsePkce               bool   `json:"use_pkce"`
+	Scope                 string `json:"scope"`
+}
+
+// LookupAuthProvider asks Rauthy to fetch the `.well-known/openid-configuration`
+// of the issuer, so the endpoints are resolved from Rauthy's point of view.
+func (c *Client) LookupAuthProvider(ctx context.Context, req *AuthProviderLookupRequest) (*AuthProviderLookup, error) {
+	var lookup AuthProviderLookup
+	_, err := c.Request(ctx, "POST", "/providers/lookup", req, &lookup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &lookup, nil
+}
@@ -20,6 +20,31 @@ func NewApiKeyAuthenticator(apiKey string) *ApiKeyAuthenticator {
 }
 
 func (a *ApiKeyAuthenticator) Authenticate(req *http.Request) error {
+	if a.apiKey == "" {
+		return fmt.Errorf("an admin API key is required, set `api_key` or `RAUTHY_API_KEY`")
+	}
+
 	req.Header.Set("Authorization", fmt.Sprintf("API-Key %s", a.apiKey))
 	return nil
 }
+
+// BearerAuthenticator authenticates with a bearer token, such as the
+// registration access token of a dynamic client. An empty token sends an
+// unauthenticated request.
+type BearerAuthenticator struct {
+	token string
+}
+
+func NewBearerAuthenticator(token string) *BearerAuthenticator {
+	return &BearerAuthenticator{
+		token: token,
+	}
+}
+
+func (a *BearerAuthenticator) Authenticate(req *http.Request) error {
+	if a.token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", a.token))
+	}
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package rauthy
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ClaimPath is a JSON path Rauthy evaluates against the claims of upstream
+// ID tokens for `admin_claim_path` and `mfa_claim_path`, e.g. `$.roles.*`.
+// Name, index and wildcard selectors are supported, as child or descendant
+// segments.
+type ClaimPath struct {
+	segments []claimPathSegment
+}
+
+type claimPathSegment struct {
+	descendant bool
+	wildcard   bool
+	name       *string
+	index      *int
+}
+
+type claimPathParser struct {
+	path string
+	pos  int
+}
+
+// ParseClaimPath parses path and reports the position of the first
+// syntax error.
+func ParseClaimPath(path string) (*ClaimPath, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("claim path must start with `$`")
+	}
+
+	p := &claimPathParser{path: path, pos: 1}
+	claimPath := &ClaimPath{}
+
+	for p.pos < len(p.path) {
+		segment, err := p.segment()
+		if err != nil {
+			return nil, err
+		}
+
+		claimPath.segments = append(claimPath.segments, segment)
+	}
+
+	return claimPath, nil
+}
+
+func (p *claimPathParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("invalid claim path at position %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *claimPathParser) peek(prefix string) bool {
+	return strings.HasPrefix(p.path[p.pos:], prefix)
+}
+
+func (p *claimPathParser) segment() (claimPathSegment, error) {
+	var segment claimPathSegment
+
+	switch {
+	case p.peek(".."):
+		segment.descendant = true
+		p.pos += 2
+
+		if p.peek("[") {
+			return p.bracket(segment)
+		}
+	case p.peek("."):
+		p.pos++
+	case p.peek("["):
+		return p.bracket(segment)
+	default:
+		return segment, p.errorf("expected `.` or `[`, got %q", p.path[p.pos:p.pos+1])
+	}
+
+	if p.peek("*") {
+		segment.wildcard = true
+		p.pos++
+
+		return segment, nil
+	}
+
+	name := p.memberName()
+	if name == "" {
+		return segment, p.errorf("expected a claim name or `*`")
+	}
+
+	segment.name = &name
+
+	return segment, nil
+}
+
+// memberName reads a shorthand name, other names must use the bracket
+// notation, e.g. `$['cognito:groups']`.
+func (p *claimPathParser) memberName() string {
+	start := p.pos
+
+	for i, r := range p.path[start:] {
+		if r != '_' && !unicode.IsLetter(r) && r <= unicode.MaxASCII && (i == 0 || !unicode.IsDigit(r)) {
+			break
+		}
+
+		p.pos = start + i + utf8.RuneLen(r)
+	}
+
+	return p.path[start:p.pos]
+}
+
+func (p *claimPathParser) bracket(segment claimPathSegment) (claimPathSegment, error) {
+	p.pos++
+
+	switch {
+	case p.peek("*"):
+		segment.wildcard = true
+		p.pos++
+	case p.peek("'"), p.peek(`"`):
+		name, err := p.quoted()
+		if err != nil {
+			return segment, err
+		}
+
+		segment.name = &name
+	default:
+		end := p.pos
+		for end < len(p.path) && (p.path[end] == '-' || unicode.IsDigit(rune(p.path[end]))) {
+			end++
+		}
+
+		index, err := strconv.Atoi(p.path[p.pos:end])
+		if err != nil {
+			return segment, p.errorf("expected a quoted name, an index or `*`")
+		}
+
+		segment.index = &index
+		p.pos = end
+	}
+
+	if !p.peek("]") {
+		return segment, p.errorf("expected `]`")
+	}
+
+	p.pos++
+
+	return segment, nil
+}
+
+func (p *claimPathParser) quoted() (string, error) {
+	quote := p.path[p.pos]
+	p.pos++
+
+	var name strings.Builder
+
+	for p.pos < len(p.path) {
+		c := p.path[p.pos]
+		p.pos++
+
+		switch {
+		case c == quote:
+			return name.String(), nil
+		case c == '\\':
+			if p.pos >= len(p.path) {
+				return "", p.errorf("unterminated escape")
+			}
+
+			name.WriteByte(p.path[p.pos])
+			p.pos++
+		default:
+			name.WriteByte(c)
+		}
+	}
+
+	return "", p.errorf("unterminated name")
+}
+
+// Evaluate returns the values path selects from claims, in document order
+// for arrays and key order for objects.
+func (c *ClaimPath) Evaluate(claims any) []any {
+	nodes := []any{claims}
+
+	for _, segment := range c.segments {
+		var selected []any
+
+		for _, node := range nodes {
+			if segment.descendant {
+				for _, descendant := range claimPathDescendants(node) {
+					selected = append(selected, segment.selectFrom(descendant)...)
+				}
+			} else {
+				selected = append(selected, segment.selectFrom(node)...)
+			}
+		}
+
+		nodes = selected
+	}
+
+	return nodes
+}
+
+func (s claimPathSegment) selectFrom(node any) []any {
+	switch value := node.(type) {
+	case map[string]any:
+		if s.name != nil {
+			if child, ok := value[*s.name]; ok {
+				return []any{child}
+			}
+		}
+
+		if s.wildcard {
+			return claimPathChildren(value)
+		}
+	case []any:
+		if s.index != nil {
+			index := *s.index
+			if index < 0 {
+				index += len(value)
+			}
+
+			if index >= 0 && index < len(value) {
+				return []any{value[index]}
+			}
+		}
+
+		if s.wildcard {
+			return value
+		}
+	}
+
+	return nil
+}
+
+func claimPathChildren(node any) []any {
+	switch value := node.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+
+		slices.Sort(keys)
+
+		children := make([]any, 0, len(keys))
+		for _, key := range keys {
+			children = append(children, value[key])
+		}
+
+		return children
+	case []any:
+		return value
+	}
+
+	return nil
+}
+
+func claimPathDescendants(node any) []any {
+	descendants := []any{node}
+
+	for _, child := range claimPathChildren(node) {
+		descendants = append(descendants, claimPathDescendants(child)...)
+	}
+
+	return descendants
+}
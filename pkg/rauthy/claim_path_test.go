@@ -0,0 +1,61 @@
+package rauthy_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+	"github.com/stretchr/testify/assert"
+)
+
+var claimPathClaims = `{
+	"sub": "1234",
+	"roles": ["user", "admin"],
+	"cognito:groups": ["ops"],
+	"realm_access": {"roles": ["offline_access", "rauthy_admin"]},
+	"amr": ["pwd", "mfa"],
+	"org": {"teams": [{"name": "infra", "admin": true}, {"name": "web"}]}
+}`
+
+func TestClaimPathEvaluate(t *testing.T) {
+	cases := map[string][]any{
+		"$.sub":                      {"1234"},
+		"$.roles.*":                  {"user", "admin"},
+		"$.roles[1]":                 {"admin"},
+		"$.roles[-1]":                {"admin"},
+		"$['cognito:groups'][*]":     {"ops"},
+		`$["realm_access"].roles[0]`: {"offline_access"},
+		"$.realm_access.roles.*":     {"offline_access", "rauthy_admin"},
+		"$.org.teams[*].name":        {"infra", "web"},
+		"$..admin":                   {true},
+		"$.missing":                  nil,
+		"$.roles[5]":                 nil,
+		"$.sub.*":                    nil,
+	}
+
+	for path, expected := range cases {
+		t.Run(path, func(t *testing.T) {
+			var claims any
+			assert.NoError(t, json.Unmarshal([]byte(claimPathClaims), &claims))
+
+			claimPath, err := rauthy.ParseClaimPath(path)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, claimPath.Evaluate(claims))
+		})
+	}
+}
+
+func TestParseClaimPath(t *testing.T) {
+	valid := []string{"$", "$.roles", "$.roles.*", "$..roles", "$..[0]", "$['a\\'b']", "$.rôles", "$.role_2"}
+	for _, path := range valid {
+		_, err := rauthy.ParseClaimPath(path)
+		assert.NoError(t, err, path)
+	}
+
+	invalid := []string{"", "roles", "$.", "$..", "$.roles[", "$.roles[x]", "$.roles['admin]", "$.2fa", "$.cognito:groups", "$ roles"}
+	for _, path := range invalid {
+		_, err := rauthy.ParseClaimPath(path)
+		assert.Error(t, err, path)
+	}
+}
@@ -5,14 +5,34 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/google/go-querystring/query"
 )
 
+// ApiError is returned by Request when Rauthy responds with a non-success status code.
+type ApiError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Body       string
+}
+
+func (e *ApiError) Error() string {
+	return fmt.Sprintf("Failed to execute request %s %s - Status Code: %d - Reason: %s", e.Method, e.Path, e.StatusCode, e.Body)
+}
+
+// IsNotFound reports whether err is an ApiError with a 404 status code.
+func IsNotFound(err error) bool {
+	var apiErr *ApiError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
 type Client struct {
 	client        *http.Client
 	authenticator Authenticator
@@ -37,11 +57,21 @@ func NewClient(endpoint string, insecure bool, authenticator Authenticator) *Cli
 	}
 }
 
+// WithAuthenticator returns a copy of the client that authenticates requests
+// with authenticator instead.
+func (c *Client) WithAuthenticator(authenticator Authenticator) *Client {
+	return &Client{
+		c.client,
+		authenticator,
+		c.endpoint,
+	}
+}
+
 func (c *Client) Request(ctx context.Context, method, path string, payload, responseBody any) (*http.Response, error) {
 	var body io.Reader
 
 	switch method {
-	case http.MethodPut, http.MethodPost, http.MethodDelete:
+	case http.MethodPut, http.MethodPost, http.MethodPatch, http.MethodDelete:
 		jsonBody, err := json.Marshal(payload)
 		if err != nil {
 			return nil, fmt.Errorf("Failed to encode JSON body %s %s - Reason: %w", method, path, err)
@@ -60,13 +90,23 @@ func (c *Client) Request(ctx context.Context, method, path string, payload, resp
 		}
 	}
 
+	return c.send(ctx, method, path, "application/json", body, responseBody)
+}
+
+// RequestForm sends form as an application/x-www-form-urlencoded body, as
+// expected by the OAuth endpoints.
+func (c *Client) RequestForm(ctx context.Context, method, path string, form url.Values, responseBody any) (*http.Response, error) {
+	return c.send(ctx, method, path, "application/x-www-form-urlencoded", strings.NewReader(form.Encode()), responseBody)
+}
+
+func (c *Client) send(ctx context.Context, method, path, contentType string, body io.Reader, responseBody any) (*http.Response, error) {
 	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/%s/%s", c.endpoint, "auth/v1", strings.TrimLeft(path, "/")), body)
 
 	if err != nil {
 		return nil, fmt.Errorf("Failed to create request %s %s - Reason: %w", method, path, err)
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 	req.Header.Set("Accept", "application/json")
 
 	if err := c.authenticator.Authenticate(req); err != nil {
@@ -80,7 +120,7 @@ func (c *Client) Request(ctx context.Context, method, path string, payload, resp
 
 	if resp.StatusCode >= 300 {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("Failed to execute request %s %s - Status Code: %d - Reason: %s", method, path, resp.StatusCode, string(body))
+		return nil, &ApiError{method, path, resp.StatusCode, string(body)}
 	}
 
 	if responseBody != nil {
@@ -23,7 +23,7 @@ func (c *Client) CreateClientSecret(ctx context.Context, clientId string, req *C
 	if req.CacheCurrentHours == 0 {
 		_, err = c.Request(ctx, http.MethodPut, fmt.Sprintf("clients/%s/secret", clientId), nil, &secret)
 	} else {
-		_, err = c.Request(ctx, http.MethodPost, fmt.Sprintf("clients/%s/secret", clientId), &req, &secret)
+		_, err = c.Request(ctx, http.MethodPut, fmt.Sprintf("clients/%s/secret", clientId), req, &secret)
 	}
 
 	if err != nil {
@@ -0,0 +1,62 @@
+package rauthy_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+	"github.com/stretchr/testify/assert"
+)
+
+var clientSecretResponse = `{
+	"id": "rauthy",
+	"confidential": true,
+	"secret": "supersecret"
+}`
+
+func TestCreateClientSecret(t *testing.T) {
+	var method string
+	var body rauthy.ClientSecretRequest
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		fmt.Fprintln(w, clientSecretResponse)
+	}))
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	secret, err := client.CreateClientSecret(context.Background(), "rauthy", &rauthy.ClientSecretRequest{CacheCurrentHours: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, "supersecret", secret.Secret)
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, 2, body.CacheCurrentHours)
+}
+
+func TestGetClientSecret(t *testing.T) {
+	ts := CreateServer(clientSecretResponse, http.StatusOK)
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	secret, err := client.GetClientSecret(context.Background(), "rauthy")
+	assert.Nil(t, err)
+	assert.Equal(t, "rauthy", secret.Id)
+	assert.True(t, secret.Confidential)
+	assert.Equal(t, "supersecret", secret.Secret)
+}
+
+func TestGetClientSecret_NotFound(t *testing.T) {
+	ts := CreateServer(``, http.StatusNotFound)
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	_, err := client.GetClientSecret(context.Background(), "rauthy")
+	assert.True(t, rauthy.IsNotFound(err))
+}
@@ -30,3 +30,26 @@ func TestRequest_BadRequest(t *testing.T) {
 
 	assert.Error(t, err)
 }
+
+func TestRequest_NotFound(t *testing.T) {
+	ts := CreateServer(`{"error": "NotFound"}`, http.StatusNotFound)
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	_, err := client.Request(context.Background(), "GET", "/test", nil, nil)
+
+	assert.Error(t, err)
+	assert.True(t, rauthy.IsNotFound(err))
+}
+
+func TestRequest_MissingApiKey(t *testing.T) {
+	ts := CreateServer(`{"id": "rauthy"}`, http.StatusOK)
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator(""))
+
+	_, err := client.Request(context.Background(), "GET", "/test", nil, nil)
+
+	assert.ErrorContains(t, err, "admin API key is required")
+}
@@ -0,0 +1,90 @@
+package rauthy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// DynamicClientRequest is the client metadata sent to the OpenID dynamic
+// client registration endpoint, see RFC 7591.
+type DynamicClientRequest struct {
+	RedirectUris             []string `json:"redirect_uris"`
+	PostLogoutRedirectUri    string   `json:"post_logout_redirect_uri,omitempty"`
+	GrantTypes               []string `json:"grant_types,omitempty"`
+	ClientName               string   `json:"client_name,omitempty"`
+	ClientUri                string   `json:"client_uri,omitempty"`
+	Contacts                 []string `json:"contacts,omitempty"`
+	TokenEndpointAuthMethod  string   `json:"token_endpoint_auth_method,omitempty"`
+	IdTokenSignedResponseAlg string   `json:"id_token_signed_response_alg,omitempty"`
+}
+
+type DynamicClient struct {
+	ClientId                 string   `json:"client_id"`
+	ClientSecret             string   `json:"client_secret,omitempty"`
+	ClientIdIssuedAt         int64    `json:"client_id_issued_at"`
+	ClientSecretExpiresAt    int64    `json:"client_secret_expires_at"`
+	RedirectUris             []string `json:"redirect_uris"`
+	PostLogoutRedirectUri    string   `json:"post_logout_redirect_uri,omitempty"`
+	GrantTypes               []string `json:"grant_types"`
+	ClientName               string   `json:"client_name,omitempty"`
+	ClientUri                string   `json:"client_uri,omitempty"`
+	Contacts                 []string `json:"contacts,omitempty"`
+	TokenEndpointAuthMethod  string   `json:"token_endpoint_auth_method"`
+	IdTokenSignedResponseAlg string   `json:"id_token_signed_response_alg"`
+	RegistrationAccessToken  string   `json:"registration_access_token,omitempty"`
+	RegistrationClientUri    string   `json:"registration_client_uri,omitempty"`
+}
+
+// RegisterDynamicClient registers a client through the public registration
+// endpoint. registrationToken is only needed when Rauthy requires an initial
+// access token for registrations.
+func (c *Client) RegisterDynamicClient(ctx context.Context, registrationToken string, req *DynamicClientRequest) (*DynamicClient, error) {
+	var client DynamicClient
+
+	if _, err := c.WithAuthenticator(NewBearerAuthenticator(registrationToken)).Request(ctx, http.MethodPost, "/clients_dyn", req, &client); err != nil {
+		return nil, err
+	}
+
+	return &client, nil
+}
+
+func (c *Client) GetDynamicClient(ctx context.Context, id, registrationAccessToken string) (*DynamicClient, error) {
+	var client DynamicClient
+
+	if _, err := c.WithAuthenticator(NewBearerAuthenticator(registrationAccessToken)).Request(ctx, http.MethodGet, fmt.Sprintf("/clients_dyn/%s", id), nil, &client); err != nil {
+		return nil, err
+	}
+
+	return &client, nil
+}
+
+func (c *Client) UpdateDynamicClient(ctx context.Context, id, registrationAccessToken string, req *DynamicClientRequest) (*DynamicClient, error) {
+	var client DynamicClient
+
+	if _, err := c.WithAuthenticator(NewBearerAuthenticator(registrationAccessToken)).Request(ctx, http.MethodPut, fmt.Sprintf("/clients_dyn/%s", id), req, &client); err != nil {
+		return nil, err
+	}
+
+	return &client, nil
+}
+
+// DeleteDynamicClient deletes the client through its registration endpoint
+// (RFC 7592). When Rauthy does not offer DELETE there it answers 404 or 405,
+// the client is then deleted through the admin API, which needs the API key
+// of c.
+func (c *Client) DeleteDynamicClient(ctx context.Context, id, registrationAccessToken string) error {
+	_, err := c.WithAuthenticator(NewBearerAuthenticator(registrationAccessToken)).Request(ctx, http.MethodDelete, fmt.Sprintf("/clients_dyn/%s", id), nil, nil)
+
+	var apiErr *ApiError
+	if !errors.As(err, &apiErr) || (apiErr.StatusCode != http.StatusNotFound && apiErr.StatusCode != http.StatusMethodNotAllowed) {
+		return err
+	}
+
+	if err := c.DeleteOidcClient(ctx, id); err != nil && !IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package rauthy_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+	"github.com/stretchr/testify/assert"
+)
+
+var dynamicClientResponse = `{
+	"client_id": "dyn$abc",
+	"client_secret": "supersecret",
+	"client_id_issued_at": 1700000000,
+	"client_secret_expires_at": 0,
+	"redirect_uris": [
+		"https://partner.example.com/callback"
+	],
+	"grant_types": [
+		"authorization_code"
+	],
+	"client_name": "Partner",
+	"token_endpoint_auth_method": "client_secret_basic",
+	"id_token_signed_response_alg": "RS256",
+	"registration_access_token": "registration-token",
+	"registration_client_uri": "https://localhost:8443/auth/v1/clients_dyn/dyn$abc"
+}`
+
+func TestRegisterDynamicClient(t *testing.T) {
+	var authorization string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintln(w, dynamicClientResponse)
+	}))
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	dynamicClient, err := client.RegisterDynamicClient(context.Background(), "", &rauthy.DynamicClientRequest{
+		RedirectUris: []string{"https://partner.example.com/callback"},
+		ClientName:   "Partner",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "", authorization)
+	assert.Equal(t, "dyn$abc", dynamicClient.ClientId)
+	assert.Equal(t, "registration-token", dynamicClient.RegistrationAccessToken)
+}
+
+func TestGetDynamicClient(t *testing.T) {
+	var authorization string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		fmt.Fprintln(w, dynamicClientResponse)
+	}))
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	dynamicClient, err := client.GetDynamicClient(context.Background(), "dyn$abc", "registration-token")
+	assert.Nil(t, err)
+	assert.Equal(t, "Bearer registration-token", authorization)
+	assert.Equal(t, "Partner", dynamicClient.ClientName)
+}
+
+func TestUpdateDynamicClient(t *testing.T) {
+	ts := CreateServer(dynamicClientResponse, http.StatusOK)
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	dynamicClient, err := client.UpdateDynamicClient(context.Background(), "dyn$abc", "registration-token", &rauthy.DynamicClientRequest{
+		RedirectUris: []string{"https://partner.example.com/callback"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "dyn$abc", dynamicClient.ClientId)
+}
+
+func TestDeleteDynamicClient(t *testing.T) {
+	ts := CreateServer("", http.StatusOK)
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	err := client.DeleteDynamicClient(context.Background(), "dyn$abc", "registration-token")
+	assert.Nil(t, err)
+}
+
+func TestDeleteDynamicClient_AdminFallback(t *testing.T) {
+	var requests []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+r.Header.Get("Authorization"))
+
+		if r.URL.Path == "/auth/v1/clients_dyn/dyn$abc" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	err := client.DeleteDynamicClient(context.Background(), "dyn$abc", "registration-token")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"DELETE /auth/v1/clients_dyn/dyn$abc Bearer registration-token",
+		"DELETE /auth/v1/clients/dyn$abc API-Key supersecret",
+	}, requests)
+}
+
+func TestDeleteDynamicClient_AlreadyDeleted(t *testing.T) {
+	ts := CreateServer("", http.StatusNotFound)
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	err := client.DeleteDynamicClient(context.Background(), "dyn$abc", "registration-token")
+	assert.NoError(t, err)
+}
+
+func TestDeleteDynamicClient_Unauthorized(t *testing.T) {
+	ts := CreateServer("", http.StatusUnauthorized)
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	err := client.DeleteDynamicClient(context.Background(), "dyn$abc", "registration-token")
+	assert.Error(t, err)
+}
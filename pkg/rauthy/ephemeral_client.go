@@ -0,0 +1,99 @@
+package rauthy
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+)
+
+// EphemeralClient is the client metadata document Rauthy fetches from the
+// client_id URL of an ephemeral client. Ephemeral clients are never stored in
+// Rauthy and are always public, so the document cannot carry a secret.
+type EphemeralClient struct {
+	ClientId                     string   `json:"client_id"`
+	ClientName                   string   `json:"client_name,omitempty"`
+	ClientUri                    string   `json:"client_uri,omitempty"`
+	Contacts                     []string `json:"contacts,omitempty"`
+	RedirectUris                 []string `json:"redirect_uris"`
+	PostLogoutRedirectUris       []string `json:"post_logout_redirect_uris,omitempty"`
+	GrantTypes                   []string `json:"grant_types,omitempty"`
+	DefaultMaxAge                *int64   `json:"default_max_age,omitempty"`
+	RequireAuthTime              *bool    `json:"require_auth_time,omitempty"`
+	AccessTokenSignedResponseAlg string   `json:"access_token_signed_response_alg,omitempty"`
+	IdTokenSignedResponseAlg     string   `json:"id_token_signed_response_alg,omitempty"`
+}
+
+var ephemeralClientGrantTypes = []string{"authorization_code", "refresh_token"}
+
+var tokenSigningAlgs = []string{"RS256", "RS384", "RS512", "EdDSA"}
+
+// ValidateEphemeralClientId checks that id is an absolute https URL, which
+// Rauthy requires to fetch the metadata document.
+func ValidateEphemeralClientId(id string) error {
+	u, err := url.Parse(id)
+	if err != nil {
+		return fmt.Errorf("client ID is not a valid URL: %w", err)
+	}
+
+	if u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("client ID must be an absolute https URL, got: %s", id)
+	}
+
+	if u.Fragment != "" {
+		return fmt.Errorf("client ID must not contain a fragment, got: %s", id)
+	}
+
+	return nil
+}
+
+// FieldError is a validation error of a single field, identified by its JSON
+// name in the document.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Validate returns every field of the document Rauthy would reject, joined as
+// *FieldError values.
+func (c EphemeralClient) Validate() error {
+	var errs []error
+
+	if err := ValidateEphemeralClientId(c.ClientId); err != nil {
+		errs = append(errs, &FieldError{"client_id", err})
+	}
+
+	if len(c.RedirectUris) == 0 {
+		errs = append(errs, &FieldError{"redirect_uris", errors.New("at least one redirect URI is required")})
+	}
+
+	for field, uris := range map[string][]string{"redirect_uris": c.RedirectUris, "post_logout_redirect_uris": c.PostLogoutRedirectUris} {
+		for _, uri := range uris {
+			if u, err := url.Parse(uri); err != nil || u.Scheme == "" || u.Host == "" {
+				errs = append(errs, &FieldError{field, fmt.Errorf("redirect URI must be an absolute URL, got: %s", uri)})
+			}
+		}
+	}
+
+	for _, grantType := range c.GrantTypes {
+		if !slices.Contains(ephemeralClientGrantTypes, grantType) {
+			errs = append(errs, &FieldError{"grant_types", fmt.Errorf("grant type %s is not supported for ephemeral clients, they are public and have no secret", grantType)})
+		}
+	}
+
+	for field, alg := range map[string]string{"access_token_signed_response_alg": c.AccessTokenSignedResponseAlg, "id_token_signed_response_alg": c.IdTokenSignedResponseAlg} {
+		if alg != "" && !slices.Contains(tokenSigningAlgs, alg) {
+			errs = append(errs, &FieldError{field, fmt.Errorf("token signing algorithm %s is not supported, expected one of %v", alg, tokenSigningAlgs)})
+		}
+	}
+
+	return errors.Join(errs...)
+}
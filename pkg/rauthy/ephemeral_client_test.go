@@ -0,0 +1,49 @@
+package rauthy_test
+
+import (
+	"testing"
+
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateEphemeralClientId(t *testing.T) {
+	assert.NoError(t, rauthy.ValidateEphemeralClientId("https://app.example.com/client.json"))
+	assert.Error(t, rauthy.ValidateEphemeralClientId("http://app.example.com/client.json"))
+	assert.Error(t, rauthy.ValidateEphemeralClientId("app"))
+	assert.Error(t, rauthy.ValidateEphemeralClientId("https://app.example.com/client.json#fragment"))
+}
+
+func TestEphemeralClientValidate(t *testing.T) {
+	client := rauthy.EphemeralClient{
+		ClientId:     "https://app.example.com/client.json",
+		RedirectUris: []string{"https://app.example.com/callback"},
+		GrantTypes:   []string{"authorization_code", "refresh_token"},
+	}
+
+	assert.NoError(t, client.Validate())
+}
+
+func TestEphemeralClientValidate_Invalid(t *testing.T) {
+	client := rauthy.EphemeralClient{
+		ClientId:                 "https://app.example.com/client.json",
+		RedirectUris:             []string{"/callback"},
+		GrantTypes:               []string{"client_credentials"},
+		IdTokenSignedResponseAlg: "HS256",
+	}
+
+	err := client.Validate()
+	assert.ErrorContains(t, err, "redirect URI must be an absolute URL")
+	assert.ErrorContains(t, err, "grant type client_credentials is not supported")
+	assert.ErrorContains(t, err, "token signing algorithm HS256 is not supported")
+
+	var fields []string
+	for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var fieldErr *rauthy.FieldError
+		if assert.ErrorAs(t, err, &fieldErr) {
+			fields = append(fields, fieldErr.Field)
+		}
+	}
+
+	assert.ElementsMatch(t, []string{"redirect_uris", "grant_types", "id_token_signed_response_alg"}, fields)
+}
@@ -21,7 +21,7 @@ type OidcClient struct {
 	Scopes              []string `json:"scopes"`
 	Challenges          []string `json:"challenges"`
 	ForceMfa            bool     `json:"force_mfa"`
-	DefaultScopes       []string `json:"default_scopes"`
+	DefaultScopes       []string `json:"default_scopes,omitempty"`
 	ClientUri           string   `json:"client_uri,omitempty"`
 	Contacts            []string `json:"contacts,omitempty"`
 }
@@ -44,6 +44,16 @@ func (c OidcClient) ToCreatePayload() CreateOidcClientPayload {
 	}
 }
 
+func (c *Client) GetOidcClients(ctx context.Context) ([]OidcClient, error) {
+	var oidcClients []OidcClient
+
+	if _, err := c.Request(ctx, http.MethodGet, "/clients", nil, &oidcClients); err != nil {
+		return oidcClients, err
+	}
+
+	return oidcClients, nil
+}
+
 func (c *Client) GetOidcClient(ctx context.Context, id string) (OidcClient, error) {
 	var oidcClient OidcClient
 
@@ -54,3 +54,19 @@ func TestGetOidcClient(t *testing.T) {
 
 	assert.Equal(t, oidcClient.Id, "rauthy")
 }
+
+func TestGetOidcClients(t *testing.T) {
+	ts := CreateServer("["+oidcClientResponse+"]", http.StatusOK)
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	oidcClients, err := client.GetOidcClients(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, oidcClients, 1)
+	assert.Equal(t, "rauthy", oidcClients[0].Id)
+}
@@ -0,0 +1,34 @@
+package rauthy
+
+import (
+	"context"
+	"net/http"
+)
+
+// OpenIdConfiguration is the subset of the OpenID Connect discovery document
+// used by the provider.
+type OpenIdConfiguration struct {
+	Issuer                           string   `json:"issuer"`
+	AuthorizationEndpoint            string   `json:"authorization_endpoint"`
+	TokenEndpoint                    string   `json:"token_endpoint"`
+	IntrospectionEndpoint            string   `json:"introspection_endpoint,omitempty"`
+	UserinfoEndpoint                 string   `json:"userinfo_endpoint"`
+	EndSessionEndpoint               string   `json:"end_session_endpoint,omitempty"`
+	RegistrationEndpoint             string   `json:"registration_endpoint,omitempty"`
+	JwksUri                          string   `json:"jwks_uri"`
+	ScopesSupported                  []string `json:"scopes_supported,omitempty"`
+	CodeChallengeMethodsSupported    []string `json:"code_challenge_methods_supported,omitempty"`
+	IdTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported,omitempty"`
+}
+
+// GetOpenIdConfiguration returns the discovery document of the Rauthy
+// instance. The document is public, the admin API key is not used.
+func (c *Client) GetOpenIdConfiguration(ctx context.Context) (*OpenIdConfiguration, error) {
+	var config OpenIdConfiguration
+
+	if _, err := c.WithAuthenticator(NewBearerAuthenticator("")).Request(ctx, http.MethodGet, "/.well-known/openid-configuration", nil, &config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
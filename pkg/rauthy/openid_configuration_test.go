@@ -0,0 +1,39 @@
+package rauthy_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+	"github.com/stretchr/testify/assert"
+)
+
+var openIdConfigurationResponse = `{
+	"issuer": "https://localhost:8443/auth/v1",
+	"authorization_endpoint": "https://localhost:8443/auth/v1/oidc/authorize",
+	"token_endpoint": "https://localhost:8443/auth/v1/oidc/token",
+	"userinfo_endpoint": "https://localhost:8443/auth/v1/oidc/userinfo",
+	"jwks_uri": "https://localhost:8443/auth/v1/oidc/certs",
+	"code_challenge_methods_supported": ["plain", "S256"]
+}`
+
+func TestGetOpenIdConfiguration(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/auth/v1/.well-known/openid-configuration", r.URL.Path)
+		assert.Empty(t, r.Header.Get("Authorization"))
+
+		fmt.Fprintln(w, openIdConfigurationResponse)
+	}))
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator(""))
+
+	config, err := client.GetOpenIdConfiguration(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "https://localhost:8443/auth/v1", config.Issuer)
+	assert.Equal(t, "https://localhost:8443/auth/v1/oidc/certs", config.JwksUri)
+	assert.Equal(t, []string{"plain", "S256"}, config.CodeChallengeMethodsSupported)
+}
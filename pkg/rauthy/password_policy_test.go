@@ -36,7 +36,7 @@ func TestGetPasswordPolicy(t *testing.T) {
 	assert.Equal(t, 1, passwordPolicy.IncludeUpperCase)
 	assert.Equal(t, 1, passwordPolicy.IncludeDigits)
 	assert.Equal(t, 180, passwordPolicy.ValidDays)
-	// assert.Equal(t, 3, passwordPolicy.NotRecentlyUsed)
+	assert.Equal(t, 3, passwordPolicy.NotRecentlyUsed)
 }
 
 func TestUpdatePasswordPolicy(t *testing.T) {
@@ -52,7 +52,7 @@ func TestUpdatePasswordPolicy(t *testing.T) {
 		IncludeUpperCase: 2,
 		IncludeDigits:    1,
 		ValidDays:        180,
-		// NotRecentlyUsed:  3,
+		NotRecentlyUsed:  3,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -64,5 +64,5 @@ func TestUpdatePasswordPolicy(t *testing.T) {
 	assert.Equal(t, 1, passwordPolicy.IncludeUpperCase)
 	assert.Equal(t, 1, passwordPolicy.IncludeDigits)
 	assert.Equal(t, 180, passwordPolicy.ValidDays)
-	// assert.Equal(t, 3, passwordPolicy.NotRecentlyUsed)
+	assert.Equal(t, 3, passwordPolicy.NotRecentlyUsed)
 }
@@ -0,0 +1,54 @@
+package rauthy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type Session struct {
+	Id       string `json:"id"`
+	ClientId string `json:"client_id"`
+	UserId   string `json:"user_id"`
+	State    string `json:"state"`
+	Exp      int64  `json:"exp"`
+}
+
+func (c *Client) GetSessions(ctx context.Context) ([]Session, error) {
+	var sessions []Session
+
+	if _, err := c.Request(ctx, http.MethodGet, "/sessions", nil, &sessions); err != nil {
+		return sessions, err
+	}
+
+	return sessions, nil
+}
+
+// GetClientSessions returns the sessions issued to the client with the given
+// ID. Rauthy has no filter for this, so every session is listed.
+func (c *Client) GetClientSessions(ctx context.Context, clientId string) ([]Session, error) {
+	sessions, err := c.GetSessions(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var clientSessions []Session
+
+	for _, session := range sessions {
+		if session.ClientId == clientId {
+			clientSessions = append(clientSessions, session)
+		}
+	}
+
+	return clientSessions, nil
+}
+
+// RevokeClientTokens invalidates every session and refresh token issued to
+// the client with the given ID. Access tokens stay valid until they expire.
+// Rauthy answers with an empty body, so nothing is decoded.
+func (c *Client) RevokeClientTokens(ctx context.Context, clientId string) error {
+	_, err := c.Request(ctx, http.MethodDelete, fmt.Sprintf("/sessions/client/%s", clientId), nil, nil)
+
+	return err
+}
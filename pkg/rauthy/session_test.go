@@ -0,0 +1,84 @@
+package rauthy_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+	"github.com/stretchr/testify/assert"
+)
+
+const sessionsResponse = `[
+	{"id": "session-1", "client_id": "rauthy", "user_id": "user-1", "state": "Auth", "exp": 1700000000},
+	{"id": "session-2", "client_id": "other", "user_id": "user-1", "state": "Auth", "exp": 1700000000},
+	{"id": "session-3", "client_id": "rauthy", "user_id": "user-2", "state": "Auth", "exp": 1700000000}
+]`
+
+func TestGetSessions(t *testing.T) {
+	ts := CreateServer(sessionsResponse, http.StatusOK)
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	sessions, err := client.GetSessions(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 3)
+	assert.Equal(t, "session-1", sessions[0].Id)
+	assert.Equal(t, "rauthy", sessions[0].ClientId)
+}
+
+func TestGetClientSessions(t *testing.T) {
+	ts := CreateServer(sessionsResponse, http.StatusOK)
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	sessions, err := client.GetClientSessions(context.Background(), "rauthy")
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 2)
+	assert.Equal(t, "session-1", sessions[0].Id)
+	assert.Equal(t, "session-3", sessions[1].Id)
+
+	sessions, err = client.GetClientSessions(context.Background(), "missing")
+	assert.NoError(t, err)
+	assert.Empty(t, sessions)
+}
+
+func TestRevokeClientTokens(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/auth/v1/sessions/client/rauthy", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	err := client.RevokeClientTokens(context.Background(), "rauthy")
+	assert.Nil(t, err)
+}
+
+func TestRevokeClientTokens_NoContent(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	err := client.RevokeClientTokens(context.Background(), "rauthy")
+	assert.Nil(t, err)
+}
+
+func TestRevokeClientTokens_NotFound(t *testing.T) {
+	ts := CreateServer(``, http.StatusNotFound)
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	err := client.RevokeClientTokens(context.Background(), "rauthy")
+	assert.True(t, rauthy.IsNotFound(err))
+}
@@ -0,0 +1,89 @@
+package rauthy
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	GrantTypeClientCredentials = "client_credentials"
+	GrantTypePassword          = "password"
+	GrantTypeRefreshToken      = "refresh_token"
+)
+
+type TokenRequest struct {
+	GrantType    string
+	ClientId     string
+	ClientSecret string
+	Username     string
+	Password     string
+	RefreshToken string
+	Scopes       []string
+}
+
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	IdToken      string `json:"id_token,omitempty"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+// IssueToken runs the grant in req against the token endpoint. The client
+// credentials are sent in the body, the admin API key is not used.
+func (c *Client) IssueToken(ctx context.Context, req *TokenRequest) (*TokenResponse, error) {
+	form := url.Values{}
+	form.Set("grant_type", req.GrantType)
+	form.Set("client_id", req.ClientId)
+
+	if req.ClientSecret != "" {
+		form.Set("client_secret", req.ClientSecret)
+	}
+
+	if req.GrantType == GrantTypePassword {
+		form.Set("username", req.Username)
+		form.Set("password", req.Password)
+	}
+
+	if req.GrantType == GrantTypeRefreshToken {
+		form.Set("refresh_token", req.RefreshToken)
+	}
+
+	if len(req.Scopes) > 0 {
+		form.Set("scope", strings.Join(req.Scopes, " "))
+	}
+
+	var token TokenResponse
+
+	if _, err := c.WithAuthenticator(NewBearerAuthenticator("")).RequestForm(ctx, http.MethodPost, "/oidc/token", form, &token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// DecodeJwtClaims returns the JSON encoded claims of a JWT. The signature is
+// not verified.
+func DecodeJwtClaims(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("token is not a JWT, expected 3 parts, got %d", len(parts))
+	}
+
+	claims, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return "", fmt.Errorf("Failed to decode JWT claims - Reason: %w", err)
+	}
+
+	if !json.Valid(claims) {
+		return "", fmt.Errorf("JWT claims are not valid JSON")
+	}
+
+	return string(claims), nil
+}
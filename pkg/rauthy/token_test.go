@@ -0,0 +1,98 @@
+package rauthy_test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+	"github.com/stretchr/testify/assert"
+)
+
+var tokenClaims = `{"sub":"rauthy","scope":"openid"}`
+
+var tokenResponse = fmt.Sprintf(`{
+	"access_token": "eyJhbGciOiJFZERTQSJ9.%s.c2lnbmF0dXJl",
+	"token_type": "Bearer",
+	"expires_in": 1800
+}`, base64.RawURLEncoding.EncodeToString([]byte(tokenClaims)))
+
+func TestIssueToken(t *testing.T) {
+	var contentType, authorization, grantType, scope string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		authorization = r.Header.Get("Authorization")
+		_ = r.ParseForm()
+		grantType = r.PostForm.Get("grant_type")
+		scope = r.PostForm.Get("scope")
+		fmt.Fprintln(w, tokenResponse)
+	}))
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	token, err := client.IssueToken(context.Background(), &rauthy.TokenRequest{
+		GrantType:    rauthy.GrantTypeClientCredentials,
+		ClientId:     "rauthy",
+		ClientSecret: "secret",
+		Scopes:       []string{"openid", "email"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "application/x-www-form-urlencoded", contentType)
+	assert.Equal(t, "", authorization)
+	assert.Equal(t, "client_credentials", grantType)
+	assert.Equal(t, "openid email", scope)
+	assert.Equal(t, int64(1800), token.ExpiresIn)
+}
+
+func TestIssueToken_RefreshToken(t *testing.T) {
+	var grantType, refreshToken string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		grantType = r.PostForm.Get("grant_type")
+		refreshToken = r.PostForm.Get("refresh_token")
+		fmt.Fprintln(w, tokenResponse)
+	}))
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	_, err := client.IssueToken(context.Background(), &rauthy.TokenRequest{
+		GrantType:    rauthy.GrantTypeRefreshToken,
+		ClientId:     "rauthy",
+		ClientSecret: "secret",
+		RefreshToken: "refresh",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "refresh_token", grantType)
+	assert.Equal(t, "refresh", refreshToken)
+}
+
+func TestIssueToken_Unauthorized(t *testing.T) {
+	ts := CreateServer(`{"error": "invalid_client"}`, http.StatusUnauthorized)
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	_, err := client.IssueToken(context.Background(), &rauthy.TokenRequest{
+		GrantType: rauthy.GrantTypeClientCredentials,
+		ClientId:  "rauthy",
+	})
+	assert.ErrorContains(t, err, "invalid_client")
+}
+
+func TestDecodeJwtClaims(t *testing.T) {
+	claims, err := rauthy.DecodeJwtClaims(fmt.Sprintf("header.%s.signature", base64.RawURLEncoding.EncodeToString([]byte(tokenClaims))))
+	assert.Nil(t, err)
+	assert.JSONEq(t, tokenClaims, claims)
+}
+
+func TestDecodeJwtClaims_Invalid(t *testing.T) {
+	_, err := rauthy.DecodeJwtClaims("not-a-jwt")
+	assert.Error(t, err)
+}
@@ -0,0 +1,87 @@
+package rauthy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type User struct {
+	Id             string  `json:"id"`
+	Email          string  `json:"email"`
+	AuthProviderId *string `json:"auth_provider_id,omitempty"`
+	FederationUid  *string `json:"federation_uid,omitempty"`
+}
+
+// UserPatchValue sets a single user attribute in a UserPatch.
+type UserPatchValue struct {
+	Key   string `json:"key"`
+	Value any    `json:"value"`
+}
+
+// UserPatch updates only the listed user attributes, Del resets them.
+type UserPatch struct {
+	Put []UserPatchValue `json:"put"`
+	Del []string         `json:"del"`
+}
+
+func (c *Client) GetUser(ctx context.Context, id string) (*User, error) {
+	var user User
+
+	if _, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/users/%s", id), nil, &user); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (c *Client) GetUserByEmail(ctx context.Context, email string) (*User, error) {
+	var user User
+
+	if _, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/users/email/%s", url.PathEscape(email)), nil, &user); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (c *Client) PatchUser(ctx context.Context, id string, patch *UserPatch) (*User, error) {
+	var user User
+
+	if _, err := c.Request(ctx, http.MethodPatch, fmt.Sprintf("/users/%s", id), patch, &user); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// LinkUserProvider federates the user with an upstream auth provider.
+// federationUid is the subject of the user at the provider, when empty
+// Rauthy keeps the one it has, so it is required for users that were never
+// federated.
+func (c *Client) LinkUserProvider(ctx context.Context, user *User, providerId string, federationUid string) (*User, error) {
+	if federationUid == "" && user.FederationUid == nil {
+		return nil, fmt.Errorf("federation_uid is required, user %s has never been federated", user.Email)
+	}
+
+	patch := &UserPatch{
+		Put: []UserPatchValue{{Key: "auth_provider_id", Value: providerId}},
+		Del: []string{},
+	}
+
+	if federationUid != "" {
+		patch.Put = append(patch.Put, UserPatchValue{Key: "federation_uid", Value: federationUid})
+	}
+
+	return c.PatchUser(ctx, user.Id, patch)
+}
+
+// UnlinkUserProvider removes the federation of the user, who has to log
+// in with a password or passkey afterwards.
+func (c *Client) UnlinkUserProvider(ctx context.Context, id string) (*User, error) {
+	return c.PatchUser(ctx, id, &UserPatch{
+		Put: []UserPatchValue{},
+		Del: []string{"auth_provider_id", "federation_uid"},
+	})
+}
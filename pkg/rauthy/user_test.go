@@ -0,0 +1,121 @@
+package rauthy_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/moonlight8978/terraform-provider-rauthy/pkg/rauthy"
+	"github.com/stretchr/testify/assert"
+)
+
+var userResponse = `{
+	"id": "user-1",
+	"email": "alice@example.com",
+	"auth_provider_id": "google",
+	"federation_uid": "1234"
+}`
+
+func TestGetUser(t *testing.T) {
+	ts := CreateServer(userResponse, http.StatusOK)
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	user, err := client.GetUser(context.Background(), "user-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "alice@example.com", user.Email)
+	assert.Equal(t, "google", *user.AuthProviderId)
+}
+
+func TestGetUserByEmail(t *testing.T) {
+	var path string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+		w.Write([]byte(userResponse))
+	}))
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	user, err := client.GetUserByEmail(context.Background(), "alice+test@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "user-1", user.Id)
+	assert.Equal(t, "/auth/v1/users/email/alice+test@example.com", path)
+}
+
+func TestLinkUserProvider(t *testing.T) {
+	var method string
+	var patch rauthy.UserPatch
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		json.NewDecoder(r.Body).Decode(&patch)
+		w.Write([]byte(userResponse))
+	}))
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	_, err := client.LinkUserProvider(context.Background(), &rauthy.User{Id: "user-1", Email: "alice@example.com"}, "google", "1234")
+	assert.NoError(t, err)
+	assert.Equal(t, http.MethodPatch, method)
+	assert.Equal(t, []rauthy.UserPatchValue{
+		{Key: "auth_provider_id", Value: "google"},
+		{Key: "federation_uid", Value: "1234"},
+	}, patch.Put)
+	assert.Empty(t, patch.Del)
+}
+
+func TestLinkUserProvider_KeepsFederationUid(t *testing.T) {
+	var patch rauthy.UserPatch
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&patch)
+		w.Write([]byte(userResponse))
+	}))
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	federationUid := "1234"
+	_, err := client.LinkUserProvider(context.Background(), &rauthy.User{Id: "user-1", Email: "alice@example.com", FederationUid: &federationUid}, "google", "")
+	assert.NoError(t, err)
+	assert.Equal(t, []rauthy.UserPatchValue{{Key: "auth_provider_id", Value: "google"}}, patch.Put)
+}
+
+func TestLinkUserProvider_FederationUidRequired(t *testing.T) {
+	requested := false
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	_, err := client.LinkUserProvider(context.Background(), &rauthy.User{Id: "user-1", Email: "alice@example.com"}, "google", "")
+	assert.ErrorContains(t, err, "federation_uid is required")
+	assert.False(t, requested)
+}
+
+func TestUnlinkUserProvider(t *testing.T) {
+	var patch rauthy.UserPatch
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&patch)
+		w.Write([]byte(`{"id": "user-1", "email": "alice@example.com"}`))
+	}))
+	defer ts.Close()
+
+	client := rauthy.NewClient(ts.URL, false, rauthy.NewApiKeyAuthenticator("supersecret"))
+
+	user, err := client.UnlinkUserProvider(context.Background(), "user-1")
+	assert.NoError(t, err)
+	assert.Nil(t, user.AuthProviderId)
+	assert.Empty(t, patch.Put)
+	assert.Equal(t, []string{"auth_provider_id", "federation_uid"}, patch.Del)
+}
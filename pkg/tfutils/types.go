@@ -26,3 +26,32 @@ func StringSliceToList(slice []string) types.List {
 
 	return types.ListValueMust(types.StringType, result)
 }
+
+func SetToStringSlice(s types.Set) []string {
+	if s.IsNull() || s.IsUnknown() {
+		return []string{}
+	}
+
+	var result []string
+	for _, val := range s.Elements() {
+		result = append(result, val.(types.String).ValueString())
+	}
+
+	return result
+}
+
+// StringSliceToSet drops duplicate values, which a set cannot hold.
+func StringSliceToSet(slice []string) types.Set {
+	var result []attr.Value
+	seen := map[string]bool{}
+	for _, val := range slice {
+		if seen[val] {
+			continue
+		}
+
+		seen[val] = true
+		result = append(result, types.StringValue(val))
+	}
+
+	return types.SetValueMust(types.StringType, result)
+}
@@ -52,3 +52,57 @@ func TestStringSliceToList_Empty(t *testing.T) {
 
 	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value(nil)), result)
 }
+
+func TestSetToStringSlice(t *testing.T) {
+	t.Parallel()
+
+	set, _ := types.SetValue(types.StringType, []attr.Value{
+		types.StringValue("a"),
+		types.StringValue("b"),
+		types.StringValue("c"),
+	})
+
+	result := tfutils.SetToStringSlice(set)
+
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, result)
+}
+
+func TestSetToStringSlice_Null(t *testing.T) {
+	t.Parallel()
+
+	result := tfutils.SetToStringSlice(types.SetNull(types.StringType))
+
+	assert.Equal(t, []string{}, result)
+}
+
+func TestStringSliceToSet(t *testing.T) {
+	t.Parallel()
+
+	result := tfutils.StringSliceToSet([]string{"c", "a", "b"})
+
+	assert.True(t, result.Equal(types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("a"),
+		types.StringValue("b"),
+		types.StringValue("c"),
+	})))
+}
+
+func TestStringSliceToSet_Duplicates(t *testing.T) {
+	t.Parallel()
+
+	result := tfutils.StringSliceToSet([]string{"a", "b", "a"})
+
+	assert.Len(t, result.Elements(), 2)
+	assert.True(t, result.Equal(types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("a"),
+		types.StringValue("b"),
+	})))
+}
+
+func TestStringSliceToSet_Empty(t *testing.T) {
+	t.Parallel()
+
+	result := tfutils.StringSliceToSet([]string{})
+
+	assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value(nil)), result)
+}